@@ -13,8 +13,9 @@ import (
 )
 
 var (
-	port    int
-	verbose bool
+	port     int
+	verbose  bool
+	profiles string
 )
 
 var (
@@ -25,11 +26,17 @@ var (
 func main() {
 	flag.IntVar(&port, "p", 9191, "HTTP listening port")
 	flag.BoolVar(&verbose, "v", false, "Enable verbose / debug output")
+	flag.StringVar(&profiles, "profiles", "", "JSON or YAML file of named scoring profiles")
 	flag.Parse()
 	log.SetFormatter(&log.TextFormatter{FullTimestamp: true})
 	if verbose {
 		log.SetLevel(log.DebugLevel)
 	}
+	if profiles != "" {
+		if err := chttpd.LoadProfiles(profiles); err != nil {
+			log.Fatal(err)
+		}
+	}
 	log.WithFields(log.Fields{
 		"version":    Version,
 		"build_date": BuildDate,
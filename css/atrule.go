@@ -0,0 +1,80 @@
+package css
+
+import (
+	"strings"
+
+	"github.com/tdewolff/parse/css"
+)
+
+// AtRule is a block at-rule enclosing declarations, e.g. `@media print` or `@keyframes fade`.
+type AtRule struct {
+	// Lower case name including the at sign, e.g. `@media`
+	Name string
+	// Prelude as written between name and block, e.g. `(prefers-color-scheme: dark)`
+	Prelude string
+}
+
+func newAtRule(name string, prelude []css.Token) AtRule {
+	return AtRule{
+		Name:    strings.ToLower(name),
+		Prelude: strings.TrimSpace(tokenString(prelude)),
+	}
+}
+
+func (a AtRule) String() string {
+	if a.Prelude == "" {
+		return a.Name
+	}
+	return a.Name + " " + a.Prelude
+}
+
+// InAtRule reports whether a mention is enclosed by an at-rule with the given name, e.g. `@keyframes`.
+func (cm *ColorMention) InAtRule(name string) bool {
+	name = "@" + strings.TrimPrefix(strings.ToLower(name), "@")
+	for _, a := range cm.AtRules {
+		if a.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPrint reports whether a mention only applies to printing, i.e. it is
+// enclosed by a `@media` rule whose queries all target print.
+func (cm *ColorMention) IsPrint() bool {
+	for _, a := range cm.AtRules {
+		if a.Name != "@media" {
+			continue
+		}
+		printOnly := true
+		for _, q := range strings.Split(strings.ToLower(a.Prelude), ",") {
+			q = strings.TrimSpace(q)
+			if !strings.Contains(q, "print") || strings.HasPrefix(q, "not ") {
+				printOnly = false
+			}
+		}
+		if printOnly {
+			return true
+		}
+	}
+	return false
+}
+
+// ColorScheme returns `light` or `dark` if a mention is enclosed by a
+// `@media (prefers-color-scheme: ...)` rule. Otherwise it is empty.
+func (cm *ColorMention) ColorScheme() string {
+	var scheme string
+	for _, a := range cm.AtRules {
+		if a.Name != "@media" {
+			continue
+		}
+		q := strings.Join(strings.Fields(strings.ToLower(a.Prelude)), "")
+		switch {
+		case strings.Contains(q, "prefers-color-scheme:dark"):
+			scheme = "dark"
+		case strings.Contains(q, "prefers-color-scheme:light"):
+			scheme = "light"
+		}
+	}
+	return scheme
+}
@@ -0,0 +1,86 @@
+package css
+
+import (
+	"testing"
+)
+
+func TestParseStylesheet_AtRules(t *testing.T) {
+	cms := ParseStylesheet(`a { color: red }
+@media print { a { color: black } }
+@media screen and (prefers-color-scheme: dark) {
+	@supports (display: grid) { body { background: #111 } }
+}
+@keyframes fade { from { color: white } 50% { color: gray } }
+@media not print, screen { a { color: blue } }
+@layer base { @container card (min-width: 1px) { a { color: green } } }
+@font-face { color: red }
+b { color: red }`)
+	var exp = []struct {
+		atRules   string
+		print     bool
+		scheme    string
+		keyframes bool
+	}{
+		{"", false, "", false},
+		{"@media print", true, "", false},
+		{"@media screen and (prefers-color-scheme:dark) @supports (display:grid)", false, "dark", false},
+		{"@keyframes fade", false, "", true},
+		{"@keyframes fade", false, "", true},
+		{"@media not print,screen", false, "", false},
+		{"@layer base @container card (min-width:1px)", false, "", false},
+		{"@font-face", false, "", false},
+		{"", false, "", false},
+	}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		var s string
+		for _, a := range cm.AtRules {
+			if s != "" {
+				s += " "
+			}
+			s += a.String()
+		}
+		if s != exp[i].atRules {
+			t.Errorf("ColorMention #%d: expected at-rules '%s', got '%s'", i, exp[i].atRules, s)
+		}
+		if cm.IsPrint() != exp[i].print {
+			t.Errorf("ColorMention #%d: expected IsPrint %t", i, exp[i].print)
+		}
+		if cm.ColorScheme() != exp[i].scheme {
+			t.Errorf("ColorMention #%d: expected color scheme '%s', got '%s'", i, exp[i].scheme, cm.ColorScheme())
+		}
+		if cm.InAtRule("keyframes") != exp[i].keyframes {
+			t.Errorf("ColorMention #%d: expected InAtRule(keyframes) %t", i, exp[i].keyframes)
+		}
+	}
+}
+
+func TestParseStylesheet_UnknownAtRuleBlock(t *testing.T) {
+	cms := ParseStylesheet(`@layer x { nav a {color:red} }
+@layer base { nav a { border: 1px solid red } }
+@LAYER y { /* } nav { */ nav.a > b { BACKGROUND: blue } }
+@Container z (min-width: 1px) { a[title="} {"] { COLOR: green } }`)
+	var exp = []struct {
+		selector string
+		property string
+		atRules  string
+	}{
+		{"nav a", "color", "@layer x"},
+		{"nav a", "border", "@layer base"},
+		{"nav.a>b", "background", "@layer y"},
+		{`a[title="} {"]`, "color", "@container z (min-width:1px)"},
+	}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Selector != exp[i].selector || cm.Property != exp[i].property {
+			t.Errorf("ColorMention #%d: expected %s { %s }, got %s { %s }", i, exp[i].selector, exp[i].property, cm.Selector, cm.Property)
+		}
+		if len(cm.AtRules) != 1 || cm.AtRules[0].String() != exp[i].atRules {
+			t.Errorf("ColorMention #%d: expected at-rules '%s', got %v", i, exp[i].atRules, cm.AtRules)
+		}
+	}
+}
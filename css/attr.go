@@ -0,0 +1,109 @@
+package css
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Property names of colors found in HTML elements and attributes instead of CSS.
+// They are prefixed to be easily told apart from CSS properties, e.g. by a Scorer.
+const (
+	// <meta name="theme-color" content="#fff">
+	PropThemeColor = "meta:theme-color"
+	// <meta name="msapplication-TileColor" content="#fff">
+	PropTileColor = "meta:msapplication-tilecolor"
+	// <link rel="mask-icon" color="#fff">
+	PropMaskIcon = "link:mask-icon"
+	// <body bgcolor="#fff"> or any other element with a bgcolor attribute
+	PropBgColor = "attr:bgcolor"
+	// <body text="#fff">
+	PropText = "attr:text"
+	// <body link="#fff">
+	PropLink = "attr:link"
+	// <body vlink="#fff">
+	PropVLink = "attr:vlink"
+	// <body alink="#fff">
+	PropALink = "attr:alink"
+	// <font color="#fff">
+	PropFontColor = "attr:color"
+)
+
+// IsHTML reports whether a mention comes from a HTML element or attribute instead of CSS.
+// Colors of a Web App Manifest are not included.
+func (cm *ColorMention) IsHTML() bool {
+	return strings.HasPrefix(cm.Property, "meta:") ||
+		strings.HasPrefix(cm.Property, "link:") ||
+		strings.HasPrefix(cm.Property, "attr:") ||
+		strings.HasPrefix(cm.Property, "svg:")
+}
+
+// bodyAttributes maps color attributes of <body> to property names.
+var bodyAttributes = map[string]string{
+	"text":  PropText,
+	"link":  PropLink,
+	"vlink": PropVLink,
+	"alink": PropALink,
+}
+
+// parseAttributes extracts colors from legacy attributes and meta elements of a single HTML element.
+// Presentation attributes of inline SVG elements are included.
+func parseAttributes(n *html.Node, selector string) []*ColorMention {
+	var cms []*ColorMention
+	add := func(value, property, selector string, legacy bool) *ColorMention {
+		c, ok := parseAttributeColor(value, legacy)
+		if !ok {
+			return nil
+		}
+		cm := c.mention(property, selector)
+		cms = append(cms, cm)
+		return cm
+	}
+	switch n.Data {
+	case "meta":
+		name := strings.ToLower(attr(n, "name"))
+		switch name {
+		case "theme-color":
+			cm := add(attr(n, "content"), PropThemeColor, `meta[name="theme-color"]`, false)
+			// <meta name="theme-color" media="(prefers-color-scheme: dark)">
+			if media := attr(n, "media"); cm != nil && media != "" {
+				cm.AtRules = []AtRule{{Name: "@media", Prelude: media}}
+			}
+		case "msapplication-tilecolor":
+			add(attr(n, "content"), PropTileColor, `meta[name="msapplication-TileColor"]`, false)
+		}
+	case "link":
+		if strings.EqualFold(attr(n, "rel"), "mask-icon") {
+			add(attr(n, "color"), PropMaskIcon, `link[rel="mask-icon"]`, false)
+		}
+	case "body":
+		for _, a := range n.Attr {
+			if prop, ok := bodyAttributes[a.Key]; ok {
+				add(a.Val, prop, selector, true)
+			}
+		}
+	case "font":
+		add(attr(n, "color"), PropFontColor, selector, true)
+	}
+	if bg := attr(n, "bgcolor"); bg != "" {
+		add(bg, PropBgColor, selector, true)
+	}
+	return append(cms, parseSVGAttributes(n, selector)...)
+}
+
+// parseAttributeColor parses the color of a HTML attribute.
+// Legacy attributes like "bgcolor" also accept hex colors without a leading `#`.
+func parseAttributeColor(s string, legacy bool) (c color, ok bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return c, false
+	}
+	if legacy && s[0] != '#' {
+		if c, ok = parseHex("#" + s); ok {
+			return c, ok
+		}
+	}
+	t := tokenize(s)
+	c, n, ok := parseColorAt(t)
+	return c, ok && n == len(t)
+}
@@ -0,0 +1,48 @@
+package css
+
+import (
+	"testing"
+)
+
+func TestParseHTML_Attributes(t *testing.T) {
+	cms, err := ParseHTML(`<html><head>
+<meta name="theme-color" content="#4285f4">
+<meta name="theme-color" content="rgb(0 0 0)" media="(prefers-color-scheme: dark)">
+<meta name="msapplication-TileColor" content="#da532c">
+<meta name="theme-color" content="not a color">
+<link rel="mask-icon" href="icon.svg" color="#5bbad5">
+</head>
+<body bgcolor="ffffff" text="#000000" link="blue" vlink="purple" alink="red">
+<table><tr><td bgcolor="#00ff00"><font color="#123">x</font></td></tr></table>
+</body></html>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct{ property, selector, hex string }{
+		{PropThemeColor, `meta[name="theme-color"]`, "#4285f4"},
+		{PropThemeColor, `meta[name="theme-color"]`, "#000000"},
+		{PropTileColor, `meta[name="msapplication-TileColor"]`, "#da532c"},
+		{PropMaskIcon, `link[rel="mask-icon"]`, "#5bbad5"},
+		{PropText, "html > body", "#000000"},
+		{PropLink, "html > body", "#0000ff"},
+		{PropVLink, "html > body", "#800080"},
+		{PropALink, "html > body", "#ff0000"},
+		{PropBgColor, "html > body", "#ffffff"},
+		{PropBgColor, "html > body > table > tbody > tr > td", "#00ff00"},
+		{PropFontColor, "html > body > table > tbody > tr > td > font", "#112233"},
+	}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Property != exp[i].property || cm.Selector != exp[i].selector || cm.Color.Hex() != exp[i].hex {
+			t.Errorf("Expecting %s %s %s, got %s %s %s", exp[i].property, exp[i].selector, exp[i].hex, cm.Property, cm.Selector, cm.Color.Hex())
+		}
+		if !cm.IsHTML() {
+			t.Errorf("Expecting ColorMention #%d to be from HTML", i)
+		}
+	}
+	if cms[0].ColorScheme() != "" || cms[1].ColorScheme() != "dark" {
+		t.Error("Expecting media attribute of theme-color to set the color scheme")
+	}
+}
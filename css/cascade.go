@@ -0,0 +1,334 @@
+package css
+
+import (
+	"strings"
+
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/nochso/colourl/page"
+	"github.com/tdewolff/parse/css"
+	"golang.org/x/net/html"
+)
+
+// Initial colors of the root element, i.e. the default text color and canvas of a browser.
+var (
+	InitialColor, _      = colorful.Hex("#000000")
+	InitialBackground, _ = colorful.Hex("#ffffff")
+)
+
+// TextColor is the effective foreground and background color of a visible text node.
+type TextColor struct {
+	// Text node and the element containing it
+	Text    *html.Node
+	Element *html.Node
+	// Foreground is the text color composited over the background.
+	Foreground colorful.Color
+	// Background is the opaque color behind the text, i.e. the backgrounds of
+	// the element and its ancestors composited over the canvas.
+	Background colorful.Color
+	// Length of the text in characters without surrounding whitespace
+	Length int
+}
+
+// cascadeProperties are the properties used by the cascade.
+var cascadeProperties = map[string]bool{
+	"color":            true,
+	"background-color": true,
+	"background":       true,
+	"display":          true,
+}
+
+// hiddenElements never render their text content.
+var hiddenElements = map[string]bool{
+	"head":     true,
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"template": true,
+	"title":    true,
+}
+
+// rule is a single declaration that takes part in the cascade.
+type rule struct {
+	property    string
+	selectors   []Selector
+	specificity Specificity
+	// order of appearance across all stylesheets
+	order     int
+	important bool
+	inline    bool
+	values    []css.Token
+}
+
+// wins reports whether a rule takes precedence over another rule for the same property.
+func (r *rule) wins(o *rule) bool {
+	switch {
+	case r.important != o.important:
+		return r.important
+	case r.inline != o.inline:
+		return r.inline
+	case r.specificity != o.specificity:
+		return o.specificity.Less(r.specificity)
+	}
+	return r.order > o.order
+}
+
+// computed style of an element.
+type computed struct {
+	color      color
+	background color
+	// effective is the opaque background behind the element
+	effective colorful.Color
+	hidden    bool
+}
+
+// ComputeText returns the effective colors of every visible text node of a Page.
+// Linked stylesheets are applied before <style> elements. See Cascade.
+func ComputeText(p *page.Page, scheme string) ([]*TextColor, error) {
+	doc, err := html.Parse(strings.NewReader(p.HTML.Body))
+	if err != nil {
+		return nil, err
+	}
+	sheets := make([]string, len(p.CSS))
+	for i, f := range p.CSS {
+		sheets[i] = f.Body
+	}
+	return Cascade(doc, sheets, scheme), nil
+}
+
+// Cascade computes the effective foreground and background colors of every visible text node.
+//
+// This is a simplified cascade: matching rules of the stylesheets, <style> elements and
+// "style" attributes are applied by importance, specificity and order. `color` is inherited
+// and the keywords `inherit`, `initial`, `unset`, `currentColor` and `transparent` are handled.
+// Rules for print and the other color scheme are ignored, any other media query is assumed to apply.
+// Elements hidden by `display: none` or the "hidden" attribute are skipped.
+func Cascade(doc *html.Node, sheets []string, scheme string) []*TextColor {
+	if scheme == "" {
+		scheme = "light"
+	}
+	vars := Vars{}
+	vars.collectHTML(doc)
+	for _, s := range sheets {
+		vars.collect(s, false, "")
+	}
+	var rules []*rule
+	add := func(s string, inline bool, selector string) {
+		rules = append(rules, cascadeRules(s, inline, selector, vars, scheme, len(rules))...)
+	}
+	for _, s := range sheets {
+		add(s, false, "")
+	}
+	eachStyle(doc, func(s string, inline bool, selector string) {
+		if !inline {
+			add(s, false, "")
+		}
+	})
+	var texts []*TextColor
+	// walk visits the children of n using its computed style
+	var walk func(n *html.Node, style *computed)
+	walk = func(n *html.Node, style *computed) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			switch c.Type {
+			case html.ElementNode:
+				if hiddenElements[c.Data] || hasAttr(c, "hidden") {
+					continue
+				}
+				child := compute(c, style, rules, vars, scheme)
+				if !child.hidden {
+					walk(c, child)
+				}
+			case html.TextNode:
+				text := strings.TrimSpace(c.Data)
+				if text == "" || n.Type != html.ElementNode {
+					continue
+				}
+				texts = append(texts, &TextColor{
+					Text:       c,
+					Element:    n,
+					Foreground: style.effective.BlendRgb(style.color.rgb, style.color.alpha),
+					Background: style.effective,
+					Length:     len([]rune(text)),
+				})
+			}
+		}
+	}
+	walk(doc, &computed{
+		color:     color{InitialColor, 1},
+		effective: InitialBackground,
+	})
+	return texts
+}
+
+// cascadeRules returns the declarations of a stylesheet or inline CSS used by the cascade.
+// order is the amount of rules collected before.
+func cascadeRules(s string, inline bool, selector string, vars Vars, scheme string, order int) []*rule {
+	var rules []*rule
+	eachDeclaration(s, inline, selector, func(d *declaration) {
+		property := strings.ToLower(d.Property)
+		if d.Custom || !cascadeProperties[property] {
+			return
+		}
+		at := &ColorMention{AtRules: d.AtRules}
+		if at.IsPrint() || at.InAtRule("@keyframes") || at.InAtRule("@font-face") || at.InAtRule("@page") {
+			return
+		}
+		if s := at.ColorScheme(); s != "" && s != scheme {
+			return
+		}
+		r := &rule{
+			property:    property,
+			specificity: d.Specificity,
+			order:       order + len(rules),
+			inline:      inline,
+		}
+		if !inline {
+			list, err := ParseSelectors(d.Selector)
+			if err != nil {
+				return
+			}
+			r.selectors = list
+		}
+		values, _, ok := vars.Resolve(d.Values, d.Selector)
+		if !ok {
+			return
+		}
+		r.values, r.important = stripImportant(values)
+		rules = append(rules, r)
+	})
+	return rules
+}
+
+// stripImportant removes a trailing `!important` from the tokens of a declaration.
+func stripImportant(t []css.Token) ([]css.Token, bool) {
+	var parts []css.Token
+	for _, tt := range t {
+		if tt.TokenType != css.WhitespaceToken {
+			parts = append(parts, tt)
+		}
+	}
+	n := len(parts)
+	if n >= 2 && parts[n-2].TokenType == css.DelimToken && string(parts[n-2].Data) == "!" &&
+		strings.EqualFold(string(parts[n-1].Data), "important") {
+		return parts[:n-2], true
+	}
+	return parts, false
+}
+
+// compute the style of an element from its parent and the winning rules.
+func compute(n *html.Node, parent *computed, rules []*rule, vars Vars, scheme string) *computed {
+	winners := map[string]*rule{}
+	for _, r := range rules {
+		if r.inline || !anyMatches(r.selectors, n) {
+			continue
+		}
+		if w := winners[r.property]; w == nil || r.wins(w) {
+			winners[r.property] = r
+		}
+	}
+	// Inline styles apply to this element only
+	for _, a := range n.Attr {
+		if a.Key != "style" {
+			continue
+		}
+		for _, r := range cascadeRules(a.Val, true, "", vars, scheme, len(rules)) {
+			if w := winners[r.property]; w == nil || r.wins(w) {
+				winners[r.property] = r
+			}
+		}
+	}
+	style := &computed{color: parent.color}
+	if r := winners["display"]; r != nil && isKeyword(r.values, "none") {
+		style.hidden = true
+		return style
+	}
+	if r := winners["color"]; r != nil {
+		if c, ok := cascadeColor(r.values, parent.color, parent.color, color{InitialColor, 1}, true, scheme); ok {
+			style.color = c
+		}
+	}
+	// Backgrounds are not inherited: the shorthand and the longhand compete by precedence
+	bg := winners["background-color"]
+	if r := winners["background"]; r != nil && (bg == nil || r.wins(bg)) {
+		bg = r
+	}
+	if bg != nil {
+		var c color
+		var ok bool
+		if bg.property == "background" {
+			c, ok = backgroundColor(bg.values, style.color, parent.background, scheme)
+		} else {
+			c, ok = cascadeColor(bg.values, style.color, parent.background, color{}, false, scheme)
+		}
+		if ok {
+			style.background = c
+		}
+	}
+	style.effective = parent.effective.BlendRgb(style.background.rgb, style.background.alpha)
+	return style
+}
+
+// cascadeColor computes the value of a color property.
+// current is the value of `currentColor` and inherited is the value of the parent.
+// `unset` acts like `inherit` for inherited properties and like `initial` otherwise.
+func cascadeColor(t []css.Token, current, inherited, initial color, inherits bool, scheme string) (color, bool) {
+	switch {
+	case isKeyword(t, "inherit"), isKeyword(t, "unset") && inherits:
+		return inherited, true
+	case isKeyword(t, "initial"), isKeyword(t, "unset"):
+		return initial, true
+	case isKeyword(t, "currentcolor"):
+		return current, true
+	}
+	parts := scanColors(t)
+	for _, p := range parts {
+		if p.scheme == "" || p.scheme == scheme {
+			return p.color, true
+		}
+	}
+	return color{}, false
+}
+
+// backgroundColor computes the background color of the `background` shorthand.
+// Without a color the background is transparent. The stops of gradients are averaged.
+func backgroundColor(t []css.Token, current, inherited color, scheme string) (color, bool) {
+	switch {
+	case isKeyword(t, "inherit"):
+		return inherited, true
+	case isKeyword(t, "initial"), isKeyword(t, "unset"), isKeyword(t, "none"):
+		return color{}, true
+	}
+	for i := len(t) - 1; i >= 0; i-- {
+		if t[i].TokenType == css.IdentToken && strings.EqualFold(string(t[i].Data), "currentcolor") {
+			return current, true
+		}
+	}
+	var plain, stops []color
+	for _, p := range scanColors(t) {
+		switch {
+		case p.scheme != "" && p.scheme != scheme:
+		case p.role != nil:
+			stops = append(stops, p.color)
+		default:
+			plain = append(plain, p.color)
+		}
+	}
+	if len(plain) > 0 { // The color is part of the last layer
+		return plain[len(plain)-1], true
+	}
+	if len(stops) == 0 {
+		return color{}, true
+	}
+	var avg color
+	for _, s := range stops {
+		avg.rgb.R += s.rgb.R / float64(len(stops))
+		avg.rgb.G += s.rgb.G / float64(len(stops))
+		avg.rgb.B += s.rgb.B / float64(len(stops))
+		avg.alpha += s.alpha / float64(len(stops))
+	}
+	return avg, true
+}
+
+// isKeyword reports whether the tokens consist of a single keyword.
+func isKeyword(t []css.Token, keyword string) bool {
+	return len(t) == 1 && t[0].TokenType == css.IdentToken && strings.EqualFold(string(t[0].Data), keyword)
+}
@@ -0,0 +1,78 @@
+package css
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestCascade(t *testing.T) {
+	doc, err := html.Parse(strings.NewReader(`<html><head><title>x</title><style>
+:root { --brand: #ff0000 }
+body { background: #000 url(x.png); color: #ffffff }
+p { color: var(--brand) }
+#main p { color: #00ff00 }
+p.note { color: #0000ff !important }
+.inherit { color: inherit }
+.current { background-color: currentColor; color: #123456 }
+.half { background-color: rgb(255 255 255 / 50%) }
+.light { color: light-dark(#111111, #eeeeee) }
+@media print { p { color: #000000 } }
+@media (prefers-color-scheme: dark) { .scheme { color: #222222 } }
+.gone { display: none }
+</style></head><body>
+Body
+<div id="main"><p>Main</p><p class="note" style="color: #ff00ff">Note</p></div>
+<p>Brand</p>
+<p style="color: #ffff00">Inline</p>
+<p><span class="inherit">Inherited</span></p>
+<p class="current">Current</p>
+<div class="half"><span>Half</span></div>
+<p class="light scheme">Light</p>
+<p class="gone">Gone</p>
+<p hidden>Hidden</p>
+<script>var x = 1</script>
+</body></html>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	texts := Cascade(doc, nil, "")
+	exp := []struct{ text, fg, bg string }{
+		{"Body", "#ffffff", "#000000"},
+		{"Main", "#00ff00", "#000000"},
+		{"Note", "#0000ff", "#000000"},
+		{"Brand", "#ff0000", "#000000"},
+		{"Inline", "#ffff00", "#000000"},
+		{"Inherited", "#ff0000", "#000000"},
+		{"Current", "#123456", "#123456"},
+		{"Half", "#ffffff", "#808080"},
+		{"Light", "#111111", "#000000"},
+	}
+	if len(texts) != len(exp) {
+		t.Fatalf("Expecting %d text nodes, got %d", len(exp), len(texts))
+	}
+	for i, tc := range texts {
+		text := strings.TrimSpace(tc.Text.Data)
+		if text != exp[i].text || tc.Foreground.Hex() != exp[i].fg || tc.Background.Hex() != exp[i].bg {
+			t.Errorf("Expecting %s %s on %s, got %s %s on %s", exp[i].text, exp[i].fg, exp[i].bg, text, tc.Foreground.Hex(), tc.Background.Hex())
+		}
+		if tc.Length != len(exp[i].text) {
+			t.Errorf("Expecting length %d for %s, got %d", len(exp[i].text), text, tc.Length)
+		}
+	}
+}
+
+func TestCascade_Scheme(t *testing.T) {
+	doc, _ := html.Parse(strings.NewReader(`<style>
+p { color: light-dark(#111111, #eeeeee) }
+@media (prefers-color-scheme: dark) { body { background: #000000 } }
+</style><p>x</p>`))
+	texts := Cascade(doc, []string{"p { background: linear-gradient(#ff0000, #0000ff) }"}, "dark")
+	if len(texts) != 1 {
+		t.Fatalf("Expecting 1 text node, got %d", len(texts))
+	}
+	if texts[0].Foreground.Hex() != "#eeeeee" || texts[0].Background.Hex() != "#800080" {
+		t.Errorf("Expecting #eeeeee on #800080, got %s on %s", texts[0].Foreground.Hex(), texts[0].Background.Hex())
+	}
+}
@@ -0,0 +1,361 @@
+package css
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/tdewolff/parse/css"
+)
+
+// color is a parsed CSS color value including its alpha channel.
+type color struct {
+	rgb   colorful.Color
+	alpha float64
+}
+
+// mention creates a ColorMention of this color for a property at a certain selector.
+func (c color) mention(property, selector string) *ColorMention {
+	cm := New(&c.rgb, property, selector)
+	cm.Alpha = c.alpha
+	return cm
+}
+
+// component is a single argument of a CSS color function, e.g. `50%`, `120deg` or `none`.
+type component struct {
+	tt   css.TokenType
+	num  float64
+	unit string
+	none bool
+}
+
+// value returns the number of a component. Percentages are relative to ref.
+func (c component) value(ref float64) (float64, bool) {
+	switch {
+	case c.none:
+		return 0, true
+	case c.tt == css.NumberToken:
+		return c.num, true
+	case c.tt == css.PercentageToken:
+		return c.num / 100 * ref, true
+	}
+	return 0, false
+}
+
+// hue returns a hue angle in degrees.
+func (c component) hue() (float64, bool) {
+	if c.none {
+		return 0, true
+	}
+	var deg float64
+	switch c.tt {
+	case css.NumberToken:
+		deg = c.num
+	case css.DimensionToken:
+		switch c.unit {
+		case "deg":
+			deg = c.num
+		case "rad":
+			deg = c.num * 180 / math.Pi
+		case "grad":
+			deg = c.num * 0.9
+		case "turn":
+			deg = c.num * 360
+		default:
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg, true
+}
+
+// parseComponent converts a single token to a component.
+func parseComponent(t css.Token) (c component, ok bool) {
+	c.tt = t.TokenType
+	s := string(t.Data)
+	var err error
+	switch t.TokenType {
+	case css.IdentToken:
+		c.none = strings.EqualFold(s, "none")
+		return c, c.none
+	case css.NumberToken:
+		c.num, err = strconv.ParseFloat(s, 64)
+	case css.PercentageToken:
+		c.num, err = strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	case css.DimensionToken:
+		n := numberLength(s)
+		c.unit = strings.ToLower(s[n:])
+		c.num, err = strconv.ParseFloat(s[:n], 64)
+	default:
+		return c, false
+	}
+	return c, err == nil
+}
+
+// numberLength returns the length of the number at the start of a dimension like `1.5turn`.
+func numberLength(s string) int {
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; {
+		case ch >= '0' && ch <= '9', ch == '.', ch == '+', ch == '-' && i == 0:
+		case (ch == 'e' || ch == 'E') && i+1 < len(s) && (s[i+1] >= '0' && s[i+1] <= '9' || s[i+1] == '-' || s[i+1] == '+'):
+			i++
+		default:
+			return i
+		}
+	}
+	return len(s)
+}
+
+// colorArgs splits the arguments of a color function into channels and an optional alpha component.
+// Both the legacy comma separated and the modern space separated syntax are accepted.
+func colorArgs(t []css.Token) (ch []component, alpha *component, ok bool) {
+	slash := false
+	for _, tt := range t {
+		switch tt.TokenType {
+		case css.WhitespaceToken, css.CommentToken, css.CommaToken:
+			continue
+		case css.DelimToken:
+			if string(tt.Data) != "/" || slash {
+				return nil, nil, false
+			}
+			slash = true
+			continue
+		}
+		c, ok := parseComponent(tt)
+		if !ok {
+			return nil, nil, false
+		}
+		if slash {
+			if alpha != nil {
+				return nil, nil, false
+			}
+			alpha = &c
+		} else {
+			ch = append(ch, c)
+		}
+	}
+	// Legacy syntax: rgba(0, 0, 0, 0.5)
+	if !slash && len(ch) == 4 {
+		alpha = &ch[3]
+		ch = ch[:3]
+	}
+	return ch, alpha, len(ch) == 3
+}
+
+// parseAlpha returns the opacity of an alpha component, defaulting to 1.
+func parseAlpha(c *component) (float64, bool) {
+	if c == nil {
+		return 1, true
+	}
+	a, ok := c.value(1)
+	return math.Max(0, math.Min(1, a)), ok
+}
+
+// values returns the numbers of all channels using the percentage reference of each channel.
+func values(ch []component, refs ...float64) (v vec3, ok bool) {
+	for i, c := range ch {
+		v[i], ok = c.value(refs[i])
+		if !ok {
+			return v, false
+		}
+	}
+	return v, true
+}
+
+// parseColorFunc parses the arguments of a color function like `rgb(` or `oklch(`.
+// name is the lower case function name without parenthesis.
+func parseColorFunc(name string, args []css.Token) (c color, ok bool) {
+	var space string
+	if name == "color" {
+		// color(display-p3 1 0 0)
+		for len(args) > 0 && args[0].TokenType == css.WhitespaceToken {
+			args = args[1:]
+		}
+		if len(args) == 0 || args[0].TokenType != css.IdentToken {
+			return c, false
+		}
+		space = strings.ToLower(string(args[0].Data))
+		args = args[1:]
+	}
+	ch, a, ok := colorArgs(args)
+	if !ok {
+		return c, false
+	}
+	c.alpha, ok = parseAlpha(a)
+	if !ok {
+		return c, false
+	}
+	var v vec3
+	switch name {
+	case "rgb", "rgba":
+		v, ok = values(ch, 255, 255, 255)
+		c.rgb = colorful.Color{R: v[0] / 255, G: v[1] / 255, B: v[2] / 255}.Clamped()
+	case "hsl", "hsla", "hwb":
+		var h float64
+		h, ok = ch[0].hue()
+		if !ok {
+			return c, false
+		}
+		v, ok = values(ch[1:], 100, 100)
+		c.rgb = hslOrHwb(name, h, v[0]/100, v[1]/100)
+	case "lab":
+		v, ok = values(ch, 100, 125, 125)
+		v[0] = math.Max(0, math.Min(100, v[0]))
+		c.rgb = gamutMap(xyzToLinearSRGB.mul(xyzD50ToD65.mul(labToXYZD50(v))))
+	case "lch":
+		v, ok = values(ch[:2], 100, 150)
+		h, ok2 := ch[2].hue()
+		ok = ok && ok2
+		v = vec3{math.Max(0, math.Min(100, v[0])), math.Max(0, v[1]), h}
+		c.rgb = gamutMap(xyzToLinearSRGB.mul(xyzD50ToD65.mul(labToXYZD50(polarToRect(v)))))
+	case "oklab":
+		v, ok = values(ch, 1, 0.4, 0.4)
+		v[0] = math.Max(0, math.Min(1, v[0]))
+		c.rgb = gamutMap(okLabToLinearSRGB(v))
+	case "oklch":
+		v, ok = values(ch[:2], 1, 0.4)
+		h, ok2 := ch[2].hue()
+		ok = ok && ok2
+		v = vec3{math.Max(0, math.Min(1, v[0])), math.Max(0, v[1]), h}
+		c.rgb = gamutMap(okLabToLinearSRGB(polarToRect(v)))
+	case "color":
+		v, ok = values(ch, 1, 1, 1)
+		lin, ok2 := predefinedToLinearSRGB(space, v)
+		ok = ok && ok2
+		c.rgb = gamutMap(lin)
+	default:
+		return c, false
+	}
+	return c, ok
+}
+
+// hslOrHwb converts hsl() and hwb() channels to sRGB.
+func hslOrHwb(name string, h, x, y float64) colorful.Color {
+	x = math.Max(0, math.Min(1, x))
+	y = math.Max(0, math.Min(1, y))
+	if name != "hwb" {
+		return colorful.Hsl(h, x, y).Clamped()
+	}
+	// hwb: x is whiteness and y is blackness
+	if x+y >= 1 {
+		gray := x / (x + y)
+		return colorful.Color{R: gray, G: gray, B: gray}
+	}
+	rgb := colorful.Hsl(h, 1, 0.5)
+	f := func(c float64) float64 { return c*(1-x-y) + x }
+	return colorful.Color{R: f(rgb.R), G: f(rgb.G), B: f(rgb.B)}.Clamped()
+}
+
+// predefinedToLinearSRGB converts channels of a predefined color space used by color() to linear sRGB.
+func predefinedToLinearSRGB(space string, v vec3) (vec3, bool) {
+	switch space {
+	case "srgb":
+		return v.transfer(srgbToLinear), true
+	case "srgb-linear":
+		return v, true
+	case "display-p3":
+		return xyzToLinearSRGB.mul(linearP3ToXYZ.mul(v.transfer(srgbToLinear))), true
+	case "a98-rgb":
+		return xyzToLinearSRGB.mul(linearA98ToXYZ.mul(v.transfer(a98ToLinear))), true
+	case "prophoto-rgb":
+		return xyzToLinearSRGB.mul(xyzD50ToD65.mul(linearProPhotoToXYZD50.mul(v.transfer(proPhotoToLinear)))), true
+	case "rec2020":
+		return xyzToLinearSRGB.mul(linearRec2020ToXYZ.mul(v.transfer(rec2020ToLinear))), true
+	case "xyz", "xyz-d65":
+		return xyzToLinearSRGB.mul(v), true
+	case "xyz-d50":
+		return xyzToLinearSRGB.mul(xyzD50ToD65.mul(v)), true
+	}
+	return v, false
+}
+
+// parseColorAt attempts to parse a color value starting at the first token.
+// Any CSS Color Module Level 4 value is accepted, e.g. `#ccc`, `cyan`, `hsl(120deg 50% 50%)` or `color(display-p3 1 0 0)`.
+// It returns the color and the amount of tokens it consists of.
+func parseColorAt(t []css.Token) (c color, n int, ok bool) {
+	if len(t) == 0 {
+		return c, 0, false
+	}
+	data := string(t[0].Data)
+	switch t[0].TokenType {
+	case css.HashToken:
+		// "#ccc" or "#cccc" hex to Color
+		c, ok = parseHex(data)
+		return c, 1, ok
+	case css.IdentToken:
+		// "transparent" is short for rgb(0 0 0 / 0)
+		if strings.EqualFold(data, "transparent") {
+			return color{}, 1, true
+		}
+		// "cyan" named to Color
+		named, ok := names[strings.ToLower(data)]
+		if !ok {
+			return c, 0, false
+		}
+		rgb, err := colorful.Hex(named)
+		return color{rgb, 1}, 1, err == nil
+	case css.FunctionToken:
+		// "rgb(0,0,0)", "oklch(70% 0.1 120)" and other functions to Color
+		end := closingParen(t)
+		if end < 0 {
+			return c, 0, false
+		}
+		name := strings.ToLower(strings.TrimSuffix(data, "("))
+		c, ok = parseColorFunc(name, t[1:end])
+		return c, end + 1, ok
+	}
+	return c, 0, false
+}
+
+// closingParen returns the index of the parenthesis closing the function or block at t[0].
+// It returns -1 if there is none.
+func closingParen(t []css.Token) int {
+	depth := 0
+	for i, tt := range t {
+		switch tt.TokenType {
+		case css.FunctionToken, css.LeftParenthesisToken:
+			depth++
+		case css.RightParenthesisToken:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseHex parses hex notation with an optional alpha channel, e.g. `#0008` or `#00000080`.
+func parseHex(s string) (c color, ok bool) {
+	if !reHex.MatchString(s) {
+		return c, false
+	}
+	s = s[1:]
+	if len(s) <= 4 { // Expand `abc` to `aabbcc`
+		long := make([]byte, 0, 8)
+		for i := 0; i < len(s); i++ {
+			long = append(long, s[i], s[i])
+		}
+		s = string(long)
+	}
+	if len(s) == 6 {
+		s += "ff"
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return c, false
+	}
+	c.rgb = colorful.Color{
+		R: float64(v>>24&0xff) / 255,
+		G: float64(v>>16&0xff) / 255,
+		B: float64(v>>8&0xff) / 255,
+	}
+	c.alpha = float64(v&0xff) / 255
+	return c, true
+}
@@ -0,0 +1,95 @@
+package css
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/tdewolff/parse/css"
+)
+
+var testsColor = []struct {
+	in    string
+	hex   string
+	alpha float64
+}{
+	{"#abc", "#aabbcc", 1},
+	{"#abc8", "#aabbcc", 0x88 / 255.0},
+	{"#00112280", "#001122", 0x80 / 255.0},
+	{"transparent", "#000000", 0},
+	{"rgb(0,1,255)", "#0001ff", 1},
+	{"rgb(0%, 50%, 100%)", "#0080ff", 1},
+	{"rgba(255, 0, 0, 0.5)", "#ff0000", 0.5},
+	{"rgb(0 0 0 / 50%)", "#000000", 0.5},
+	{"rgb(none 255 none)", "#00ff00", 1},
+	{"rgb(300 -5 0)", "#ff0000", 1},
+	{"hsl(120deg 100% 50%)", "#00ff00", 1},
+	{"hsla(0.5turn, 100%, 50%, .3)", "#00ffff", 0.3},
+	{"hsl(240 100 50)", "#0000ff", 1},
+	{"hwb(0 0% 0%)", "#ff0000", 1},
+	{"hwb(90 50% 50%)", "#808080", 1},
+	{"lab(100% 0 0)", "#ffffff", 1},
+	{"lab(50 0 0)", "#777777", 1},
+	{"lch(50% 0 0 / 0.5)", "#777777", 0.5},
+	{"oklab(1 0 0)", "#ffffff", 1},
+	{"oklch(62.8% 0.2577 29.23)", "#ff0000", 1},
+	{"oklch(0% 0 none)", "#000000", 1},
+	{"color(srgb 1 0.5 0)", "#ff8000", 1},
+	{"color(srgb-linear 1 1 1)", "#ffffff", 1},
+	{"color(xyz-d65 0.9505 1 1.089)", "#ffffff", 1},
+	{"DarkGray", "#a9a9a9", 1},
+	{"1px solid #001122", "#001122", 1},
+}
+
+func tokens(s string) []css.Token {
+	p := css.NewParser(strings.NewReader("a:"+s), true)
+	p.Next()
+	return p.Values()
+}
+
+// parseColor returns the first color of a declaration.
+func parseColor(t []css.Token) (color, bool) {
+	parts := parseColors("color", t)
+	if len(parts) == 0 {
+		return color{}, false
+	}
+	return parts[0].color, true
+}
+
+func TestParseColor(t *testing.T) {
+	for _, tt := range testsColor {
+		c, ok := parseColor(tokens(tt.in))
+		if !ok {
+			t.Errorf("%s: expected color %s, got none", tt.in, tt.hex)
+			continue
+		}
+		if c.rgb.Hex() != tt.hex {
+			t.Errorf("%s: expected color %s, got %s", tt.in, tt.hex, c.rgb.Hex())
+		}
+		if math.Abs(c.alpha-tt.alpha) > 0.001 {
+			t.Errorf("%s: expected alpha %f, got %f", tt.in, tt.alpha, c.alpha)
+		}
+	}
+}
+
+func TestParseColor_Invalid(t *testing.T) {
+	for _, in := range []string{"rgb(1 2)", "rgb(calc(1) 2 3)", "hsl(1em 2% 3%)", "color(foo 1 1 1)", "lab(1 2 3 / 4 / 5)", "invalid"} {
+		c, ok := parseColor(tokens(in))
+		if ok {
+			t.Errorf("%s: expected no color, got %s", in, c.rgb.Hex())
+		}
+	}
+}
+
+func TestParseColor_GamutMapping(t *testing.T) {
+	for _, in := range []string{"color(display-p3 1 0 0)", "color(rec2020 0 1 0)", "oklch(90% 0.4 140)", "lch(50% 200 300)"} {
+		c, ok := parseColor(tokens(in))
+		if !ok {
+			t.Errorf("%s: expected color, got none", in)
+			continue
+		}
+		if !c.rgb.IsValid() {
+			t.Errorf("%s: expected color within sRGB gamut, got %v", in, c)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package css
+
+import (
+	"math"
+
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// vec3 holds the three channels of a colour in any colour space.
+type vec3 [3]float64
+
+// mat3 is a 3x3 matrix for converting between linear colour spaces.
+type mat3 [3]vec3
+
+func (m mat3) mul(v vec3) vec3 {
+	return vec3{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
+
+// Conversion matrices as listed in CSS Color Module Level 4, section 18.
+var (
+	linearSRGBToXYZ = mat3{
+		{506752.0 / 1228815, 87881.0 / 245763, 12673.0 / 70218},
+		{87098.0 / 409605, 175762.0 / 245763, 12673.0 / 175545},
+		{7918.0 / 409605, 87881.0 / 737289, 1001167.0 / 1053270},
+	}
+	xyzToLinearSRGB = mat3{
+		{12831.0 / 3959, -329.0 / 214, -1974.0 / 3959},
+		{-851781.0 / 878810, 1648619.0 / 878810, 36519.0 / 878810},
+		{705.0 / 12673, -2585.0 / 12673, 705.0 / 667},
+	}
+	linearP3ToXYZ = mat3{
+		{608311.0 / 1250200, 189793.0 / 714400, 198249.0 / 1000160},
+		{35783.0 / 156275, 247089.0 / 357200, 198249.0 / 2500400},
+		{0, 32229.0 / 714400, 5220557.0 / 5000800},
+	}
+	linearA98ToXYZ = mat3{
+		{573536.0 / 994567, 263643.0 / 1420810, 187206.0 / 994567},
+		{591459.0 / 1989134, 6239551.0 / 9945670, 374412.0 / 4972835},
+		{53769.0 / 1989134, 351524.0 / 4972835, 4929758.0 / 4972835},
+	}
+	linearProPhotoToXYZD50 = mat3{
+		{0.7977666449006423, 0.13518129740053308, 0.0313477341283922},
+		{0.2880748288194013, 0.711835234241873, 0.00008993693872564},
+		{0, 0, 0.8251046025104602},
+	}
+	linearRec2020ToXYZ = mat3{
+		{63426534.0 / 99577255, 20160776.0 / 139408157, 47086771.0 / 278816314},
+		{26158966.0 / 99577255, 472592308.0 / 697040785, 8267143.0 / 139408157},
+		{0, 19567812.0 / 697040785, 295819943.0 / 278816314},
+	}
+	// Bradford chromatic adaptation from D50 to D65
+	xyzD50ToD65 = mat3{
+		{0.955473421488075, -0.02309845494876471, 0.06325924320057072},
+		{-0.0283697093338637, 1.0099953980813041, 0.021041441191917323},
+		{0.012314014864481998, -0.020507649298898964, 1.330365926242124},
+	}
+	// OKLab as defined by Björn Ottosson, based on linear sRGB
+	linearSRGBToLMS = mat3{
+		{0.4122214708, 0.5363325363, 0.0514459929},
+		{0.2119034982, 0.6806995451, 0.1073969566},
+		{0.0883024619, 0.2817188376, 0.6299787005},
+	}
+	lmsToOKLab = mat3{
+		{0.2104542553, 0.7936177850, -0.0040720468},
+		{1.9779984951, -2.4285922050, 0.4505937099},
+		{0.0259040371, 0.7827717662, -0.8086757660},
+	}
+	okLabToLMS = mat3{
+		{1, 0.3963377774, 0.2158037573},
+		{1, -0.1055613458, -0.0638541728},
+		{1, -0.0894841775, -1.2914855480},
+	}
+	lmsToLinearSRGB = mat3{
+		{4.0767416621, -3.3077115913, 0.2309699292},
+		{-1.2684380046, 2.6097574011, -0.3413193965},
+		{-0.0041960863, -0.7034186147, 1.7076147010},
+	}
+)
+
+// D50 reference white used by CSS lab() and lch()
+var d50 = vec3{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+
+// Just noticeable difference used when gamut mapping in OKLab.
+const gamutJND = 0.02
+
+// transfer applies a transfer function to every channel while keeping the sign.
+func (v vec3) transfer(f func(float64) float64) vec3 {
+	for i, c := range v {
+		if c < 0 {
+			v[i] = -f(-c)
+		} else {
+			v[i] = f(c)
+		}
+	}
+	return v
+}
+
+func srgbToLinear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(c float64) float64 {
+	if c <= 0.0031308 {
+		return c * 12.92
+	}
+	return 1.055*math.Pow(c, 1/2.4) - 0.055
+}
+
+func a98ToLinear(c float64) float64 {
+	return math.Pow(c, 563.0/256)
+}
+
+func proPhotoToLinear(c float64) float64 {
+	if c <= 16.0/512 {
+		return c / 16
+	}
+	return math.Pow(c, 1.8)
+}
+
+func rec2020ToLinear(c float64) float64 {
+	const alpha, beta = 1.09929682680944, 0.018053968510807
+	if c < beta*4.5 {
+		return c / 4.5
+	}
+	return math.Pow((c+alpha-1)/alpha, 1/0.45)
+}
+
+// labToXYZD50 converts CIE Lab to XYZ relative to D50.
+func labToXYZD50(lab vec3) vec3 {
+	const kappa, epsilon = 24389.0 / 27, 216.0 / 24389
+	f1 := (lab[0] + 16) / 116
+	f0 := lab[1]/500 + f1
+	f2 := f1 - lab[2]/200
+	var xyz vec3
+	if f0*f0*f0 > epsilon {
+		xyz[0] = f0 * f0 * f0
+	} else {
+		xyz[0] = (116*f0 - 16) / kappa
+	}
+	if lab[0] > kappa*epsilon {
+		xyz[1] = math.Pow((lab[0]+16)/116, 3)
+	} else {
+		xyz[1] = lab[0] / kappa
+	}
+	if f2*f2*f2 > epsilon {
+		xyz[2] = f2 * f2 * f2
+	} else {
+		xyz[2] = (116*f2 - 16) / kappa
+	}
+	for i := range xyz {
+		xyz[i] *= d50[i]
+	}
+	return xyz
+}
+
+// polarToRect converts lightness, chroma and hue in degrees to L, a and b.
+func polarToRect(lch vec3) vec3 {
+	h := lch[2] * math.Pi / 180
+	return vec3{lch[0], lch[1] * math.Cos(h), lch[1] * math.Sin(h)}
+}
+
+func okLabToLinearSRGB(lab vec3) vec3 {
+	lms := okLabToLMS.mul(lab)
+	for i, c := range lms {
+		lms[i] = c * c * c
+	}
+	return lmsToLinearSRGB.mul(lms)
+}
+
+func linearSRGBToOKLab(rgb vec3) vec3 {
+	return lmsToOKLab.mul(linearSRGBToLMS.mul(rgb).transfer(math.Cbrt))
+}
+
+func inGamut(rgb vec3) bool {
+	const eps = 0.000001
+	for _, c := range rgb {
+		if c < -eps || c > 1+eps {
+			return false
+		}
+	}
+	return true
+}
+
+func clip(rgb vec3) vec3 {
+	for i, c := range rgb {
+		rgb[i] = math.Max(0, math.Min(1, c))
+	}
+	return rgb
+}
+
+func deltaEOK(a, b vec3) float64 {
+	return math.Sqrt((a[0]-b[0])*(a[0]-b[0]) + (a[1]-b[1])*(a[1]-b[1]) + (a[2]-b[2])*(a[2]-b[2]))
+}
+
+// gamutMap converts linear sRGB, possibly out of gamut, to a displayable sRGB color.
+// Out of gamut colors are mapped by reducing OKLCH chroma as described in
+// CSS Color Module Level 4, section 13.2.
+func gamutMap(lin vec3) colorful.Color {
+	rgb := lin.transfer(linearToSRGB)
+	if inGamut(rgb) {
+		rgb = clip(rgb)
+		return colorful.Color{R: rgb[0], G: rgb[1], B: rgb[2]}
+	}
+	lab := linearSRGBToOKLab(lin)
+	if lab[0] >= 1 {
+		return colorful.Color{R: 1, G: 1, B: 1}
+	}
+	if lab[0] <= 0 {
+		return colorful.Color{}
+	}
+	chroma := math.Hypot(lab[1], lab[2])
+	hue := math.Atan2(lab[2], lab[1]) * 180 / math.Pi
+	clipped := clip(rgb)
+	toOKLab := func(rgb vec3) vec3 {
+		return linearSRGBToOKLab(rgb.transfer(srgbToLinear))
+	}
+	if deltaEOK(toOKLab(clipped), lab) < gamutJND {
+		return colorful.Color{R: clipped[0], G: clipped[1], B: clipped[2]}
+	}
+	const eps = 0.0001
+	min, max := 0.0, chroma
+	minInGamut := true
+	for max-min > eps {
+		c := (min + max) / 2
+		current := polarToRect(vec3{lab[0], c, hue})
+		rgb = okLabToLinearSRGB(current).transfer(linearToSRGB)
+		if minInGamut && inGamut(rgb) {
+			min = c
+			continue
+		}
+		clipped = clip(rgb)
+		e := deltaEOK(toOKLab(clipped), current)
+		if e < gamutJND {
+			if gamutJND-e < eps {
+				break
+			}
+			minInGamut = false
+			min = c
+		} else {
+			max = c
+		}
+	}
+	return colorful.Color{R: clipped[0], G: clipped[1], B: clipped[2]}
+}
@@ -0,0 +1,151 @@
+package css
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// Content describes the visible content of the elements a ColorMention applies to.
+// Nested elements matched by the same selector are only counted once.
+type Content struct {
+	// Text is the amount of visible characters within the elements.
+	Text int
+	// Descendants is the amount of elements within the elements.
+	Descendants int
+	// Position of the first element in document order, from 0 (top) to 1 (bottom).
+	Position float64
+	// Prominent is true if an element is part of a header, navigation or hero area.
+	Prominent bool
+}
+
+// prominentTags are elements of prominent areas near the top of most pages.
+var prominentTags = map[string]bool{
+	"header": true,
+	"nav":    true,
+}
+
+// prominentRoles are ARIA landmarks of prominent areas.
+var prominentRoles = map[string]bool{
+	"banner":     true,
+	"navigation": true,
+}
+
+// prominentWords are parts of ids and classes commonly used for prominent areas.
+var prominentWords = []string{"hero", "banner", "masthead", "header", "navbar", "jumbotron"}
+
+// nodeContent is the content of a single element and its descendants.
+type nodeContent struct {
+	index       int
+	text        int
+	descendants int
+	prominent   bool
+}
+
+// measureContent sets the Content of mentions of the HTML document, stylesheets and background images.
+// "style" and HTML attributes apply to the element they are defined on.
+func measureContent(doc *html.Node, cms []*ColorMention) {
+	elems := elements(doc)
+	nodes := map[*html.Node]*nodeContent{}
+	var walk func(n *html.Node, prominent bool) *nodeContent
+	walk = func(n *html.Node, prominent bool) *nodeContent {
+		nc := &nodeContent{index: len(nodes), prominent: prominent || isProminent(n)}
+		nodes[n] = nc
+		visible := !hiddenElements[n.Data] && !hasAttr(n, "hidden")
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			switch c.Type {
+			case html.ElementNode:
+				child := walk(c, nc.prominent)
+				if visible {
+					nc.text += child.text
+					nc.descendants += child.descendants + 1
+				}
+			case html.TextNode:
+				if visible {
+					nc.text += utf8.RuneCountInString(strings.TrimSpace(c.Data))
+				}
+			}
+		}
+		return nc
+	}
+	for c := doc.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode {
+			walk(c, false)
+		}
+	}
+	measured := map[string]*Content{}
+	for _, cm := range cms {
+		if cm.Source != SourceDocument && cm.Source != SourceImage || strings.HasPrefix(cm.Selector, "@") {
+			continue
+		}
+		key := cm.Selector
+		if cm.Inline || cm.IsHTML() {
+			key = "\x00" + key
+		}
+		content, ok := measured[key]
+		if !ok {
+			if list, err := ParseSelectors(cm.Selector); err == nil {
+				var matched []*html.Node
+				for _, n := range elems {
+					if anyMatches(list, n) {
+						matched = append(matched, n)
+						// The context selector of an attribute refers to a single element
+						if cm.Inline || cm.IsHTML() {
+							break
+						}
+					}
+				}
+				content = sumContent(matched, nodes, len(elems))
+			}
+			measured[key] = content
+		}
+		if content != nil {
+			c := *content
+			cm.Content = &c
+		}
+	}
+}
+
+// sumContent adds up the content of matched elements that are not nested within another matched element.
+func sumContent(matched []*html.Node, nodes map[*html.Node]*nodeContent, total int) *Content {
+	content := &Content{}
+	if len(matched) == 0 || total == 0 {
+		return content
+	}
+	set := map[*html.Node]bool{}
+	for _, n := range matched {
+		set[n] = true
+	}
+	content.Position = float64(nodes[matched[0]].index) / float64(total)
+	for _, n := range matched {
+		nested := false
+		for p := parentElement(n); p != nil && !nested; p = parentElement(p) {
+			nested = set[p]
+		}
+		nc := nodes[n]
+		if nc.prominent {
+			content.Prominent = true
+		}
+		if nested {
+			continue
+		}
+		content.Text += nc.text
+		content.Descendants += nc.descendants
+	}
+	return content
+}
+
+// isProminent reports whether an element is a header, navigation or hero area.
+func isProminent(n *html.Node) bool {
+	if prominentTags[n.Data] || prominentRoles[strings.ToLower(attr(n, "role"))] {
+		return true
+	}
+	names := strings.ToLower(attr(n, "id") + " " + attr(n, "class"))
+	for _, w := range prominentWords {
+		if strings.Contains(names, w) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/nochso/colourl/page"
+)
+
+func TestParsePage_Content(t *testing.T) {
+	cml, err := ParsePage(&page.Page{HTML: &page.File{Body: `<html><head><style>
+.hero { color: #001122 }
+div { color: #112233 }
+.missing { color: #223344 }
+@font-face { color: #334455 }
+</style></head><body>
+<header class="hero"><h1>Title</h1></header>
+<div>Outer <div>Inner</div></div>
+<p style="color: #445566">Inline text<script>var x</script></p>
+<p hidden style="color: #556677">Hidden</p>
+</body></html>`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []*Content{
+		{Text: 5, Descendants: 1, Position: 4.0 / 11, Prominent: true},
+		{Text: 10, Descendants: 1, Position: 6.0 / 11},
+		{},
+		nil,
+		{Text: 11, Descendants: 1, Position: 8.0 / 11},
+		{Position: 10.0 / 11},
+	}
+	if len(cml.Mentions) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cml.Mentions))
+	}
+	for i, cm := range cml.Mentions {
+		c := cm.Content
+		switch {
+		case c == nil && exp[i] == nil:
+		case c == nil || exp[i] == nil || *c != *exp[i]:
+			t.Errorf("Expecting content %+v for %s, got %+v", exp[i], cm.Selector, c)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package css
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
 	"regexp"
 	"strconv"
@@ -17,12 +18,86 @@ import (
 // ColorMention is a single occurrence of a color in a certain context.
 type ColorMention struct {
 	Color *colorful.Color
+	// Opacity of the color from 0 (transparent) to 1 (opaque)
+	Alpha float64
 	// color, background-color
 	Property string
 	// .class, nav > a
 	// Selectors for inline CSS are based on Context structs.
 	// Otherwise the typical CSS selector is used.
+	// Selector lists like `h1, h2` result in one ColorMention per selector.
 	Selector string
+	// Specificity of the selector. It is zero for inline CSS, HTML attributes and other sources.
+	Specificity Specificity
+	// Inline is true for colors of "style" attributes, which take precedence over any selector.
+	Inline bool
+	// Depth of the element in the HTML document, starting at 1 for <html>.
+	// It is 0 for colors of stylesheets and <style> elements.
+	Depth int
+	// Elements is the amount of elements of the HTML document the selector matches.
+	// It is only counted by ParsePage.
+	Elements int
+	// Unused is true if the selector matches no element of the HTML document.
+	Unused bool
+	// Content affected by the color. It is only measured by ParsePage and nil
+	// for colors of other sources like icons or if the selector can not be parsed.
+	Content *Content
+	// Name of the custom property the color was resolved from, e.g. `--primary`
+	Var string
+	// Role of the color within a value containing multiple colors, e.g. a gradient stop.
+	// nil if the color is not part of a gradient, shadow or border.
+	Role *Role
+	// Enclosing at-rules like `@media print`, starting with the outermost one.
+	AtRules []AtRule
+	// Scheme is `light` or `dark` if the color is an argument of light-dark().
+	// See ColorScheme() for colors within `prefers-color-scheme` media queries.
+	Scheme string
+	// Source of the color, e.g. a stylesheet or an icon.
+	Source Source
+	// Weight is the share of pixels of an image with this color from 0 to 1.
+	// It is 0 for colors that are not from raster images.
+	// Colors of background images keep the Property and Selector of the declaration using the image.
+	Weight float64
+	// Location of the color within a stylesheet, <style> element or "style" attribute.
+	// nil for other sources or if the CSS could not be located within its HTML document.
+	Location *Location
+	// Literal is the color as written, e.g. `cornflowerblue` or `hsl(120 50% 50%)`.
+	// Colors resolved from var() use the value of the custom property.
+	Literal string
+}
+
+// Source describes what kind of file a ColorMention comes from.
+type Source int
+
+// Sources of a ColorMention.
+const (
+	// SourceDocument is a HTML document or CSS stylesheet
+	SourceDocument Source = iota
+	// SourceManifest is a Web App Manifest
+	SourceManifest
+	// SourceLogo is a SVG image that looks like a logo
+	SourceLogo
+	// SourceIcon is a raster image like a favicon or touch icon
+	SourceIcon
+	// SourceImage is a raster image used by a background property
+	SourceImage
+)
+
+// String returns the name of a Source.
+func (s Source) String() string {
+	switch s {
+	case SourceDocument:
+		return "document"
+	case SourceManifest:
+		return "manifest"
+	case SourceLogo:
+		return "logo"
+	case SourceIcon:
+		return "icon"
+	case SourceImage:
+		return "image"
+	}
+	return "unknown"
 }
 
 // CML ColorMention List
@@ -30,28 +105,17 @@ type CML struct {
 	// URL where the colors are from
 	URL      *url.URL
 	Mentions []*ColorMention
+	// ColorScheme lists the color schemes supported by the page as declared by
+	// the `color-scheme` property or meta element, e.g. `light dark`.
+	ColorScheme []string
 }
 
 // Context is a stack of selectors, like element names and class or id attributes.
 // It describes the hierarchy in a HTML document.
 type Context []string
 
-// Match `#012` or `#001122`, `rgb(0,1,2)` and `rgb(0%,1%,2%)`
-var reHex = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
-var reRGB = regexp.MustCompile(`(?i)rgb\(` +
-	// Allow whitespace like *0*,*
-	`\s*([0-9]{1,3})\s*,` +
-	`\s*([0-9]{1,3})\s*,` +
-	`\s*([0-9]{1,3})\s*` +
-	`\)`,
-)
-var reRGBPerc = regexp.MustCompile(`(?i)rgb\(` +
-	// Allow whitespace like *0%*,*
-	`\s*([0-9]{1,3})%\s*,` +
-	`\s*([0-9]{1,3})%\s*,` +
-	`\s*([0-9]{1,3})%\s*` +
-	`\)`,
-)
+// Match `#012`, `#0123`, `#001122` or `#00112233`
+var reHex = regexp.MustCompile(`^#([0-9A-Fa-f]{3,4}|[0-9A-Fa-f]{6}|[0-9A-Fa-f]{8})$`)
 
 // Push a selector on the stack.
 func (c *Context) Push(ctx string) {
@@ -74,185 +138,429 @@ func (c Context) String() string {
 	return strings.Join(c, " > ")
 }
 
-// New ColorMention of a color for a property at a certain selector.
+// New ColorMention of an opaque color for a property at a certain selector.
 func New(c *colorful.Color, property, selector string) *ColorMention {
 	return &ColorMention{
 		Color:    c,
+		Alpha:    1,
 		Property: property,
 		Selector: selector,
 	}
 }
 
-// ParsePage returns a CML containing all CSS colors.
+// ParsePage returns a CML containing all CSS colors, the colors of the Web App Manifest,
+// SVG logos, icons and background images.
+// Custom properties are resolved across all style elements, attributes and stylesheets.
+// Selectors are matched against the HTML document to find unused rules and measure their content.
 func ParsePage(p *page.Page) (*CML, error) {
-	cml := &CML{URL: p.HTML.URL}
-	var err error
-	cml.Mentions, err = ParseHTML(p.HTML.Body)
+	doc, err := html.Parse(strings.NewReader(p.HTML.Body))
 	if err != nil {
 		return nil, err
 	}
+	vars := pageVars(doc, p.CSS)
+	cml := &CML{URL: p.HTML.URL}
+	cml.Mentions = parseDocument(doc, vars, newSource(p.HTML.URL, p.HTML.Body))
+	cml.ColorScheme = declaredColorScheme(doc, p.CSS)
 	for _, css := range p.CSS {
-		cml.Mentions = append(cml.Mentions, ParseStylesheet(css.Body)...)
+		cml.Mentions = append(cml.Mentions, parseDeclarations(css.Body, false, "", vars, newSource(css.URL, css.Body))...)
+	}
+	// An invalid manifest is ignored like any CSS without colors
+	if p.Manifest != nil {
+		cms, err := ParseManifest(p.Manifest)
+		if err == nil {
+			cml.Mentions = append(cml.Mentions, cms...)
+		}
 	}
+	for _, logo := range p.Logos {
+		cms, err := ParseSVG(logo.Body)
+		if err != nil {
+			continue
+		}
+		for _, cm := range cms {
+			cm.Source = SourceLogo
+			if cm.Location != nil {
+				cm.Location.URL = logo.URL
+			}
+		}
+		cml.Mentions = append(cml.Mentions, cms...)
+	}
+	// Icons that can not be decoded are ignored as well
+	for _, icon := range p.Icons {
+		cms, err := ParseIcon(icon)
+		if err == nil {
+			cml.Mentions = append(cml.Mentions, cms...)
+		}
+	}
+	if len(p.Images) > 0 {
+		img := newImages(p.Images)
+		src := newSource(p.HTML.URL, p.HTML.Body)
+		eachElement(doc, func(n *html.Node, selector string) {
+			styles(n, selector, func(s string, inline bool, selector string) {
+				cms := parseImages(s, inline, selector, p.HTML.URL, img, vars, src.embedded(s, inline))
+				if inline {
+					setDepth(cms, nodeDepth(n))
+				}
+				cml.Mentions = append(cml.Mentions, cms...)
+			})
+		})
+		for _, css := range p.CSS {
+			cml.Mentions = append(cml.Mentions, parseImages(css.Body, false, "", css.URL, img, vars, newSource(css.URL, css.Body))...)
+		}
+	}
+	countElements(doc, cml.Mentions)
+	measureContent(doc, cml.Mentions)
 	return cml, nil
 }
 
 // ParseHTML extract colors from "style" attributes and elements.
+// Legacy attributes like "bgcolor", meta elements like "theme-color" and
+// presentation attributes of inline SVG like "fill" are included.
 func ParseHTML(s string) ([]*ColorMention, error) {
 	r := strings.NewReader(s)
 	doc, err := html.Parse(r)
 	if err != nil {
 		return nil, err
 	}
-	var f func(*html.Node)
+	vars := Vars{}
+	vars.collectHTML(doc)
+	return parseDocument(doc, vars, newSource(nil, s)), nil
+}
+
+// parseDocument extracts colors from "style" attributes and elements of a parsed HTML document.
+// Colors of legacy attributes and meta elements are included as well.
+// CSS is located within the HTML source src.
+func parseDocument(doc *html.Node, vars Vars, src *source) []*ColorMention {
 	mentions := []*ColorMention{}
-	context := Context{}
-	f = func(n *html.Node) {
-		if n.Type == html.ElementNode {
-			ctx := n.Data
-			// Update context using attributes "id" and "class"
-			for _, attr := range n.Attr {
-				if attr.Key == "id" {
-					ctx += "#" + attr.Val
-				} else if attr.Key == "class" {
-					ctx += "." + attr.Val
-				}
+	eachElement(doc, func(n *html.Node, selector string) {
+		depth := nodeDepth(n)
+		mentions = append(mentions, setDepth(parseAttributes(n, selector), depth)...)
+		styles(n, selector, func(s string, inline bool, selector string) {
+			cms := parseDeclarations(s, inline, selector, vars, src.embedded(s, inline))
+			if inline {
+				setDepth(cms, depth)
 			}
-			context.Push(ctx)
+			mentions = append(mentions, cms...)
+		})
+	})
+	return mentions
+}
 
-			// Look for a style="" attribute
-			for _, attr := range n.Attr {
-				if attr.Key == "style" {
-					mentions = append(mentions, parseStyleAttribute(attr.Val, context.String())...)
-				}
-			}
-			// Look for a <style> element
-			if n.Data == "style" && n.FirstChild != nil {
-				mentions = append(mentions, ParseStylesheet(n.FirstChild.Data)...)
-			}
+// setDepth sets the DOM depth of mentions from the same element.
+func setDepth(cms []*ColorMention, depth int) []*ColorMention {
+	for _, cm := range cms {
+		cm.Depth = depth
+	}
+	return cms
+}
+
+// collectHTML adds custom property definitions of "style" attributes and elements.
+func (v Vars) collectHTML(doc *html.Node) {
+	eachStyle(doc, v.collect)
+}
+
+// pageVars returns the custom properties of a HTML document and its stylesheets.
+func pageVars(doc *html.Node, sheets []*page.File) Vars {
+	vars := Vars{}
+	vars.collectHTML(doc)
+	for _, css := range sheets {
+		vars.collect(css.Body, false, "")
+	}
+	return vars
+}
+
+// eachElement calls f for every element of a HTML document.
+// Selectors are based on Context structs, e.g. `html > body > ul.nav > li:nth-child(2)`.
+func eachElement(doc *html.Node, f func(n *html.Node, selector string)) {
+	var walk func(*html.Node)
+	context := Context{}
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			context.Push(elementSelector(n))
+			f(n, context.String())
 		}
 		for c := n.FirstChild; c != nil; c = c.NextSibling {
-			f(c)
+			walk(c)
 		}
 		if n.Type == html.ElementNode {
 			_, _ = context.Pop()
 		}
 	}
-	f(doc)
-	return mentions, nil
+	walk(doc)
 }
 
-// ParseStylesheet extracts colors from a full CSS stylesheet.
-func ParseStylesheet(sheet string) []*ColorMention {
-	p := css.NewParser(strings.NewReader(sheet), false)
-	var selector string
-	var cms []*ColorMention
-	for {
-		gt, tt, data := p.Next()
-		if gt == css.ErrorGrammar {
-			// ignore *asterisks froms browser/version specific rules
-			if tt == css.DelimToken && string(data) == "*" {
-				continue
-			}
-			break
-		}
-		// Remember the selector for the upcoming declarations
-		if gt == css.BeginRulesetGrammar {
-			selector = tokenString(p.Values())
+// elementSelector returns a compound selector for a single element using its
+// tag name, "id" and "class" attributes, e.g. `li.item.active:nth-child(2)`.
+// The position is only added if the element has no id and a sibling has the same tag name.
+func elementSelector(n *html.Node) string {
+	s := n.Data
+	id := attr(n, "id")
+	if id != "" {
+		s += "#" + escapeIdent(id)
+	}
+	for _, class := range strings.Fields(attr(n, "class")) {
+		s += "." + escapeIdent(class)
+	}
+	if id != "" {
+		return s
+	}
+	if n.Parent == nil {
+		return s
+	}
+	index, position, ambiguous := 0, 0, false
+	for c := n.Parent.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode {
+			continue
 		}
-		if gt == css.DeclarationGrammar {
-			c, ok := parseColor(p.Values())
-			if ok {
-				cms = append(cms, New(c, string(data), selector))
-			}
+		index++
+		if c == n {
+			position = index
+		} else if c.Data == n.Data {
+			ambiguous = true
 		}
 	}
-	return cms
-}
-
-// tokenString returns a single string from a list of css.Token
-func tokenString(t []css.Token) string {
-	var s string
-	for _, tt := range t {
-		s += string(tt.Data)
+	if ambiguous {
+		s += ":nth-child(" + strconv.Itoa(position) + ")"
 	}
 	return s
 }
 
-// parseStyleAttribute extracts ColorMentions from an inline CSS (i.e. a style attribute).
-func parseStyleAttribute(s string, selector string) []*ColorMention {
-	p := css.NewParser(strings.NewReader(s), true)
-	var cms []*ColorMention
-	for {
-		gt, _, data := p.Next()
-		if gt == css.ErrorGrammar {
-			break
-		}
-		if gt != css.DeclarationGrammar {
-			continue
+// nodeDepth returns the depth of an element within a HTML document, starting at 1 for <html>.
+func nodeDepth(n *html.Node) int {
+	depth := 0
+	for ; n != nil; n = n.Parent {
+		if n.Type == html.ElementNode {
+			depth++
 		}
-		c, ok := parseColor(p.Values())
-		if ok {
-			cms = append(cms, New(c, string(data), selector))
+	}
+	return depth
+}
+
+// escapeIdent escapes an identifier like a class name for use in a selector.
+func escapeIdent(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == 0:
+			b.WriteRune('\uFFFD')
+		case r < 0x20 || r == 0x7f,
+			r >= '0' && r <= '9' && (i == 0 || i == 1 && s[0] == '-'):
+			fmt.Fprintf(&b, "\\%x ", r)
+		case r == '-' && len(s) == 1:
+			b.WriteString(`\-`)
+		case r >= 0x80 || r == '-' || r == '_' ||
+			r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('\\')
+			b.WriteRune(r)
 		}
 	}
-	return cms
+	return b.String()
 }
 
-// parseColor attempts to extract a color from list of CSS tokens.
-// It is expected that the Tokens come from a Declaration.
-func parseColor(t []css.Token) (c *colorful.Color, ok bool) {
-	s := tokenString(t)
+// eachStyle calls f for every "style" attribute and element of a HTML document.
+func eachStyle(doc *html.Node, f func(s string, inline bool, selector string)) {
+	eachElement(doc, func(n *html.Node, selector string) {
+		styles(n, selector, f)
+	})
+}
 
-	// "#ccc" hex to Color
-	hex := reHex.FindString(s)
-	if hex != "" {
-		c, err := colorful.Hex(hex)
-		if err == nil {
-			return &c, true
+// styles calls f for the "style" attribute of an element and the content of <style> elements.
+func styles(n *html.Node, selector string, f func(s string, inline bool, selector string)) {
+	// Look for a style="" attribute
+	for _, attr := range n.Attr {
+		if attr.Key == "style" {
+			f(attr.Val, true, selector)
 		}
 	}
+	// Look for a <style> element
+	if n.Data == "style" && n.FirstChild != nil {
+		f(n.FirstChild.Data, false, "")
+	}
+}
 
-	// "rgb(0,0,0)" to Color
-	sm := reRGB.FindStringSubmatch(s)
-	if sm != nil {
-		var err error
-		rgb := make([]float64, 3)
-		for i, v := range sm[1:] {
-			rgb[i], err = strconv.ParseFloat(v, 64)
-			if err != nil {
-				return nil, false
-			}
+// ParseStylesheet extracts colors from a full CSS stylesheet.
+// Only custom properties defined within the same stylesheet are resolved.
+func ParseStylesheet(sheet string) []*ColorMention {
+	vars := Vars{}
+	vars.collect(sheet, false, "")
+	return parseDeclarations(sheet, false, "", vars, newSource(nil, sheet))
+}
+
+// declaration of a single property within a stylesheet or inline CSS.
+type declaration struct {
+	Property string
+	Selector string
+	// Specificity of the selector. It is zero for inline CSS and at-rules like @font-face.
+	Specificity Specificity
+	Values      []css.Token
+	AtRules     []AtRule
+	// Custom is true for custom property definitions like `--primary: #f00`
+	Custom bool
+	// Offset of the property within the CSS, -1 if it could not be located.
+	Offset int
+	// Value as written and its offset within the CSS.
+	Value       string
+	ValueOffset int
+}
+
+// ruleSelector is a single selector of a selector list like `h1, h2`.
+type ruleSelector struct {
+	selector    string
+	specificity Specificity
+}
+
+// splitSelectors returns each selector of a selector list and its specificity.
+// Selectors that can not be parsed have a specificity of zero.
+func splitSelectors(t []css.Token) []ruleSelector {
+	var list []ruleSelector
+	for _, part := range splitCommas(t) {
+		rs := ruleSelector{selector: strings.TrimSpace(tokenString(part))}
+		if sel, err := parseSelector(part); err == nil {
+			rs.specificity = sel.Specificity()
 		}
-		c := colorful.Color{R: rgb[0] / 255.0, G: rgb[1] / 255.0, B: rgb[2] / 255.0}
-		return &c, true
+		list = append(list, rs)
 	}
+	return list
+}
+
+// eachDeclaration calls f for every declaration of a stylesheet or inline CSS (i.e. a style attribute).
+// f is called once for every selector of a selector list like `h1, h2`.
+// The selector is only used for inline CSS.
+func eachDeclaration(s string, inline bool, selector string, f func(d *declaration)) {
+	eachDeclarationIn(s, 0, inline, selector, nil, f)
+}
 
-	// "rgb(0%,0%,0%)" to Color
-	sm = reRGBPerc.FindStringSubmatch(s)
-	if sm != nil {
-		var err error
-		rgb := make([]float64, 3)
-		for i, v := range sm[1:] {
-			rgb[i], err = strconv.ParseFloat(v, 64)
-			if err != nil {
-				return nil, false
+// eachDeclarationIn calls f for every declaration of CSS enclosed by at-rules.
+// Blocks of at-rules unknown to the parser are sliced from s and parsed again.
+// base is the offset of s within the original CSS and is added to offsets of declarations.
+func eachDeclarationIn(s string, base int, inline bool, selector string, atRules []AtRule, f func(d *declaration)) {
+	p := css.NewParser(strings.NewReader(s), inline)
+	o := newOffsets(s)
+	// Raw block of an at-rule unknown to the parser, e.g. @layer or @container
+	blockStart, blockEnd := -1, -1
+	// Selectors of a list preceding the last one, e.g. `h1` of `h1, h2`
+	var qualified []css.Token
+	selectors := []ruleSelector{{selector: selector}}
+	for {
+		gt, tt, data := p.Next()
+		if gt == css.ErrorGrammar {
+			// ignore *asterisks froms browser/version specific rules
+			if !inline && tt == css.DelimToken && string(data) == "*" {
+				continue
+			}
+			break
+		}
+		start, end := o.next(tt, data)
+		// Offsets of the first and last value found within s
+		valueStart, valueEnd := -1, -1
+		switch gt {
+		case css.AtRuleGrammar, css.BeginAtRuleGrammar, css.QualifiedRuleGrammar, css.BeginRulesetGrammar, css.DeclarationGrammar:
+			for _, v := range p.Values() {
+				if vs, ve := o.next(v.TokenType, v.Data); vs >= 0 {
+					if valueStart < 0 {
+						valueStart = vs
+					}
+					valueEnd = ve
+				}
+			}
+		case css.CustomPropertyGrammar:
+			o.skipValue()
+		}
+		switch gt {
+		case css.BeginAtRuleGrammar:
+			atRules = append(atRules, newAtRule(string(data), p.Values()))
+			// Declarations directly within at-rules like @font-face or @page
+			selector = atRules[len(atRules)-1].Name
+			selectors = []ruleSelector{{selector: selector}}
+		case css.TokenGrammar:
+			if len(atRules) > 0 && start >= 0 {
+				if blockStart < 0 {
+					blockStart = start
+				}
+				blockEnd = end
+			}
+		case css.EndAtRuleGrammar:
+			if len(atRules) == 0 {
+				continue
+			}
+			if blockStart >= 0 {
+				eachDeclarationIn(s[blockStart:blockEnd], base+blockStart, false, selector, atRules, f)
+				blockStart = -1
+			}
+			atRules = atRules[:len(atRules)-1]
+		case css.QualifiedRuleGrammar:
+			qualified = append(append(qualified, copyTokens(p.Values())...), commaToken)
+		case css.BeginRulesetGrammar:
+			// Remember the selectors for the upcoming declarations
+			selectors = splitSelectors(append(qualified, p.Values()...))
+			qualified = nil
+		case css.DeclarationGrammar, css.CustomPropertyGrammar:
+			offset, value, valueOffset := -1, "", -1
+			if start >= 0 {
+				offset = base + start
+			}
+			if valueStart >= 0 {
+				value, valueOffset = s[valueStart:valueEnd], base+valueStart
+			}
+			for _, rs := range selectors {
+				f(&declaration{
+					Property:    string(data),
+					Selector:    rs.selector,
+					Specificity: rs.specificity,
+					Values:      p.Values(),
+					AtRules:     append([]AtRule(nil), atRules...),
+					Custom:      gt == css.CustomPropertyGrammar,
+					Offset:      offset,
+					Value:       value,
+					ValueOffset: valueOffset,
+				})
 			}
 		}
-		c := colorful.Color{R: rgb[0] / 100.0, G: rgb[1] / 100.0, B: rgb[2] / 100.0}
-		return &c, true
 	}
+}
 
-	// "cyan" named to Color by checking each token individually
-	for _, tt := range t {
-		named, ok := names[string(tt.Data)]
-		if ok {
-			c, err := colorful.Hex(named)
-			if err != nil {
-				continue
+// parseDeclarations extracts ColorMentions from a stylesheet or inline CSS.
+// Any var() references are resolved using vars. Mentions are located within src if not nil.
+func parseDeclarations(s string, inline bool, selector string, vars Vars, src *source) []*ColorMention {
+	var cms []*ColorMention
+	eachDeclaration(s, inline, selector, func(d *declaration) {
+		if d.Custom {
+			return
+		}
+		values, name, ok := vars.Resolve(d.Values, d.Selector)
+		if !ok {
+			return
+		}
+		// Colors appear in order within the value as written
+		from := 0
+		for _, p := range parseColors(d.Property, values) {
+			cm := p.mention(d.Property, d.Selector)
+			cm.Literal = p.literal
+			offset := d.Offset
+			if i, raw := findLiteral(d.Value, p.literal, from); i >= 0 {
+				offset = d.ValueOffset + i
+				from = i + len(raw)
+				cm.Literal = raw
 			}
-			return &c, true
+			cm.Location = src.location(offset)
+			cm.Specificity = d.Specificity
+			cm.Inline = inline
+			cm.Var = name
+			cm.Role = p.role
+			cm.AtRules = d.AtRules
+			cm.Scheme = p.scheme
+			cms = append(cms, cm)
 		}
+	})
+	return cms
+}
+
+// tokenString returns a single string from a list of css.Token
+func tokenString(t []css.Token) string {
+	var s string
+	for _, tt := range t {
+		s += string(tt.Data)
 	}
-	return nil, false
+	return s
 }
@@ -130,3 +130,38 @@ func TestContext_Pop_Error(t *testing.T) {
 		t.Error("Popping from an empty stack must return an error")
 	}
 }
+
+func TestParseHTML_InlineSelectors(t *testing.T) {
+	cms, err := ParseHTML(`<ul class=" nav  main ">
+<li style="color:red"></li>
+<li id="x" style="color:red"></li>
+<li class="md:flex 2col" style="color:red"></li>
+</ul>
+<p style="color:red"></p>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct {
+		selector string
+		depth    int
+	}{
+		{"html > body > ul.nav.main > li:nth-child(1)", 4},
+		{"html > body > ul.nav.main > li#x", 4},
+		{`html > body > ul.nav.main > li.md\:flex.\32 col:nth-child(3)`, 4},
+		{"html > body > p", 3},
+	}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Selector != exp[i].selector || cm.Depth != exp[i].depth {
+			t.Errorf("Expecting %s at depth %d, got %s at depth %d", exp[i].selector, exp[i].depth, cm.Selector, cm.Depth)
+		}
+		if !cm.Inline {
+			t.Errorf("Expecting ColorMention #%d to be inline", i)
+		}
+		if _, err := ParseSelectors(cm.Selector); err != nil {
+			t.Errorf("Expecting a valid selector, got %s: %s", cm.Selector, err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package css
+
+import (
+	"github.com/nochso/colourl/page"
+	"github.com/nochso/colourl/raster"
+)
+
+// PropIcon is the property name of colors found in icons.
+const PropIcon = "image:icon"
+
+// MaxIconColors limits the amount of colors extracted from a single icon.
+var MaxIconColors = 8
+
+// ParseIcon extracts the most common colors of a raster image like a favicon.
+// Each ColorMention is weighted by the share of opaque pixels with its color.
+// The selector of each ColorMention is the URL of the icon.
+func ParseIcon(f *page.File) ([]*ColorMention, error) {
+	img, err := raster.Decode([]byte(f.Body))
+	if err != nil {
+		return nil, err
+	}
+	var selector string
+	if f.URL != nil {
+		selector = f.URL.String()
+	}
+	var cms []*ColorMention
+	for _, c := range raster.Quantize(img, MaxIconColors) {
+		cm := color{rgb: c.Color, alpha: 1}.mention(PropIcon, selector)
+		cm.Source = SourceIcon
+		cm.Weight = c.Weight
+		cms = append(cms, cm)
+	}
+	return cms, nil
+}
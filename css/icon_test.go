@@ -0,0 +1,65 @@
+package css
+
+import (
+	"bytes"
+	"image"
+	imagecolor "image/color"
+	"image/png"
+	"testing"
+
+	"github.com/nochso/colourl/page"
+)
+
+// encodePNG returns a PNG w pixels wide filled row by row with pixels.
+func encodePNG(t *testing.T, w int, pixels ...imagecolor.NRGBA) string {
+	img := image.NewNRGBA(image.Rect(0, 0, w, (len(pixels)+w-1)/w))
+	for i, c := range pixels {
+		img.SetNRGBA(i%w, i/w, c)
+	}
+	var buf bytes.Buffer
+	err := png.Encode(&buf, img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestParseIcon(t *testing.T) {
+	orange := imagecolor.NRGBA{R: 0xff, G: 0x66, A: 0xff}
+	blue := imagecolor.NRGBA{B: 0xff, A: 0xff}
+	body := encodePNG(t, 4,
+		orange, orange, orange, orange,
+		orange, orange, orange, orange,
+		blue, blue, blue, blue,
+		imagecolor.NRGBA{}, imagecolor.NRGBA{}, imagecolor.NRGBA{}, imagecolor.NRGBA{},
+	)
+	cms, err := ParseIcon(&page.File{Body: body})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct {
+		hex    string
+		weight float64
+	}{
+		{"#ff6600", 2.0 / 3},
+		{"#0000ff", 1.0 / 3},
+	}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Color.Hex() != exp[i].hex || cm.Weight != exp[i].weight {
+			t.Errorf("Expecting %s with weight %f, got %s with %f", exp[i].hex, exp[i].weight, cm.Color.Hex(), cm.Weight)
+		}
+		if cm.Source != SourceIcon || cm.Property != PropIcon {
+			t.Errorf("Expecting ColorMention #%d to be from an icon", i)
+		}
+	}
+}
+
+func TestParseIcon_Invalid(t *testing.T) {
+	_, err := ParseIcon(&page.File{Body: "not an image"})
+	if err == nil {
+		t.Error("Expecting an error for invalid images")
+	}
+}
@@ -0,0 +1,144 @@
+package css
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/nochso/colourl/page"
+	"github.com/nochso/colourl/raster"
+	"github.com/tdewolff/parse/css"
+	"golang.org/x/net/html"
+)
+
+// MaxImageColors limits the amount of dominant colors extracted from a single background image.
+var MaxImageColors = 5
+
+// images maps URLs of downloaded images to their dominant colors.
+type images struct {
+	files  map[string]*page.File
+	colors map[string][]raster.Color
+}
+
+// newImages prepares downloaded images for lookups by URL.
+func newImages(files []*page.File) *images {
+	img := &images{
+		files:  map[string]*page.File{},
+		colors: map[string][]raster.Color{},
+	}
+	for _, f := range files {
+		if f.URL != nil {
+			img.files[f.URL.String()] = f
+		}
+	}
+	return img
+}
+
+// Colors returns the dominant colors of an image. Images are decoded once.
+// ok is false if the image was not downloaded or can not be decoded.
+func (img *images) Colors(u *url.URL) (colors []raster.Color, ok bool) {
+	key := u.String()
+	if colors, ok = img.colors[key]; ok {
+		return colors, colors != nil
+	}
+	f, ok := img.files[key]
+	if !ok {
+		return nil, false
+	}
+	decoded, err := raster.Decode([]byte(f.Body))
+	if err == nil {
+		colors = raster.Cluster(decoded, MaxImageColors)
+	}
+	img.colors[key] = colors
+	return colors, colors != nil
+}
+
+// ImageURLs returns the unique URLs of images used by background properties of a Page in order of appearance.
+// Custom properties are resolved like in ParsePage and URLs are resolved relative to the file containing the CSS.
+// The images can be downloaded by page.Page.AddImages before calling ParsePage.
+func ImageURLs(p *page.Page) ([]*url.URL, error) {
+	doc, err := html.Parse(strings.NewReader(p.HTML.Body))
+	if err != nil {
+		return nil, err
+	}
+	vars := pageVars(doc, p.CSS)
+	var urls []*url.URL
+	seen := map[string]bool{}
+	add := func(_ *declaration, _ string, u *url.URL, _ int) {
+		if (u.Scheme != "http" && u.Scheme != "https") || seen[u.String()] {
+			return
+		}
+		seen[u.String()] = true
+		urls = append(urls, u)
+	}
+	eachStyle(doc, func(s string, inline bool, selector string) {
+		eachImage(s, inline, selector, p.HTML.URL, vars, add)
+	})
+	for _, css := range p.CSS {
+		eachImage(css.Body, false, "", css.URL, vars, add)
+	}
+	return urls, nil
+}
+
+// parseImages extracts the dominant colors of images referenced by url() in background properties.
+// Mentions are located at the url() within src.
+func parseImages(s string, inline bool, selector string, base *url.URL, img *images, vars Vars, src *source) []*ColorMention {
+	var cms []*ColorMention
+	eachImage(s, inline, selector, base, vars, func(d *declaration, name string, u *url.URL, offset int) {
+		colors, ok := img.Colors(u)
+		if !ok {
+			return
+		}
+		for _, c := range colors {
+			cm := color{rgb: c.Color, alpha: 1}.mention(d.Property, d.Selector)
+			cm.Specificity = d.Specificity
+			cm.Inline = inline
+			cm.AtRules = d.AtRules
+			cm.Source = SourceImage
+			cm.Weight = c.Weight
+			cm.Var = name
+			cm.Location = src.location(offset)
+			cms = append(cms, cm)
+		}
+	})
+	return cms
+}
+
+// eachImage calls f for every url() in background properties.
+// Any var() references are resolved using vars and name is the first custom property used.
+// URLs are resolved relative to base, i.e. the URL of the stylesheet or HTML document.
+// Like in browsers this includes URLs of custom properties defined elsewhere.
+// offset is the position of the url() within s or of the declaration if the URL is not written there.
+func eachImage(s string, inline bool, selector string, base *url.URL, vars Vars, f func(d *declaration, name string, u *url.URL, offset int)) {
+	eachDeclaration(s, inline, selector, func(d *declaration) {
+		if d.Custom || !isBackground(d.Property) {
+			return
+		}
+		values, name, ok := vars.Resolve(d.Values, d.Selector)
+		if !ok {
+			return
+		}
+		from := 0
+		for _, t := range values {
+			if t.TokenType != css.URLToken {
+				continue
+			}
+			u, err := base.Parse(page.TokenURL(t))
+			if err != nil {
+				continue
+			}
+			offset := d.Offset
+			if i, raw := findLiteral(d.Value, "url(", from); i >= 0 {
+				offset = d.ValueOffset + i
+				from = i + len(raw)
+			}
+			f(d, name, u, offset)
+		}
+	})
+}
+
+// isBackground reports whether a property can contain background images,
+// e.g. `background` or `background-image`.
+func isBackground(property string) bool {
+	property = strings.ToLower(property)
+	return property == "background" || property == "background-image"
+}
@@ -0,0 +1,90 @@
+package css
+
+import (
+	imagecolor "image/color"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/nochso/colourl/page"
+)
+
+func TestImageURLs(t *testing.T) {
+	base, _ := url.Parse("https://example.com/index.html")
+	sheet, _ := url.Parse("https://example.com/css/style.css")
+	urls, err := ImageURLs(&page.Page{
+		HTML: &page.File{Body: `<div style="background-image: url(a.png)"></div>
+<style>:root { --hero: url(g.png); --chain: var(--hero) } .a { background: url("a.png") no-repeat }</style>`, URL: base},
+		CSS: []*page.File{{Body: `
+.a { background-image: url(b.png), linear-gradient(#fff, #000), url("c.jpg") }
+.b { BACKGROUND: #fff url( 'd.gif' ) no-repeat }
+.c { list-style-image: url(e.png); --x: url(f.png) }
+@media screen { .d { background: url(/h.png) } }
+.e { background: var(--chain) no-repeat, var(--missing, url(i.png)) }
+.f { background: url(data:image/png;base64,AAAA) }`, URL: sheet}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, u := range urls {
+		paths = append(paths, u.Path)
+	}
+	exp := []string{"/a.png", "/css/b.png", "/css/c.jpg", "/css/d.gif", "/h.png", "/css/g.png", "/css/i.png"}
+	if !reflect.DeepEqual(paths, exp) {
+		t.Errorf("Expecting %v, got %v", exp, paths)
+	}
+}
+
+func TestParsePage_Images(t *testing.T) {
+	red := imagecolor.NRGBA{R: 0xff, A: 0xff}
+	base, _ := url.Parse("https://example.com/index.html")
+	sheet, _ := url.Parse("https://example.com/css/style.css")
+	hero, _ := url.Parse("https://example.com/css/hero.png")
+	cml, err := ParsePage(&page.Page{
+		HTML:   &page.File{Body: `<div style="background: url(css/hero.png)">`, URL: base},
+		CSS:    []*page.File{{Body: `@media screen { .hero { background-image: url(hero.png) } }`, URL: sheet}},
+		Images: []*page.File{{Body: encodePNG(t, 2, red, red, imagecolor.NRGBA{}, imagecolor.NRGBA{}), URL: hero}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct{ property, selector string }{
+		{"background", "html > body > div"},
+		{"background-image", ".hero"},
+	}
+	if len(cml.Mentions) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cml.Mentions))
+	}
+	for i, cm := range cml.Mentions {
+		if cm.Property != exp[i].property || cm.Selector != exp[i].selector {
+			t.Errorf("Expecting %s %s, got %s %s", exp[i].property, exp[i].selector, cm.Property, cm.Selector)
+		}
+		if cm.Color.Hex() != "#ff0000" || cm.Weight != 1 || cm.Source != SourceImage {
+			t.Errorf("Expecting red image color with weight 1, got %s with %f", cm.Color.Hex(), cm.Weight)
+		}
+	}
+	if !cml.Mentions[1].InAtRule("@media") {
+		t.Error("Expecting image colors to keep at-rules of the declaration")
+	}
+}
+
+func TestParsePage_ImageVars(t *testing.T) {
+	sheet, _ := url.Parse("https://example.com/style.css")
+	hero, _ := url.Parse("https://example.com/hero.png")
+	cml, err := ParsePage(&page.Page{
+		HTML:   &page.File{Body: `<style>:root { --hero: url(hero.png) }</style>`, URL: sheet},
+		CSS:    []*page.File{{Body: `.hero { background-image: var(--hero) } .x { background: var(--missing) }`, URL: sheet}},
+		Images: []*page.File{{Body: encodePNG(t, 1, imagecolor.NRGBA{B: 0xff, A: 0xff}), URL: hero}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cml.Mentions) != 1 {
+		t.Fatalf("Expecting 1 ColorMention, got %d", len(cml.Mentions))
+	}
+	cm := cml.Mentions[0]
+	if cm.Selector != ".hero" || cm.Color.Hex() != "#0000ff" || cm.Var != "--hero" {
+		t.Errorf("Expecting blue image color of .hero using --hero, got %s %s %s", cm.Selector, cm.Color.Hex(), cm.Var)
+	}
+}
@@ -0,0 +1,251 @@
+package css
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// Location of a ColorMention within the file it was found in.
+type Location struct {
+	// URL of the stylesheet or HTML document. nil if the CSS was parsed directly.
+	URL *url.URL
+	// Line and Column of the color literal, starting at 1.
+	// Colors resolved from var() point at the declaration instead.
+	Line   int
+	Column int
+}
+
+// String returns the location as `url:line:column`.
+func (l *Location) String() string {
+	if l.URL == nil {
+		return fmt.Sprintf("%d:%d", l.Line, l.Column)
+	}
+	return fmt.Sprintf("%s:%d:%d", l.URL, l.Line, l.Column)
+}
+
+// source is CSS within a file like a stylesheet or HTML document.
+type source struct {
+	url  *url.URL
+	text string
+	// offset of the CSS within text, -1 if unknown
+	offset int
+	// index maps offsets within the CSS to offsets within text if they differ,
+	// e.g. for "style" attributes containing character references like `&quot;`
+	index []int
+	// pos is where the search for the next embedded CSS starts
+	pos int
+}
+
+// newSource returns the source of a whole file.
+func newSource(u *url.URL, text string) *source {
+	return &source{url: u, text: text}
+}
+
+// embedded returns the source of CSS embedded in a HTML document.
+// Embedded CSS must be passed in order of appearance.
+func (src *source) embedded(s string, inline bool) *source {
+	if src == nil {
+		return nil
+	}
+	e := &source{url: src.url, text: src.text, offset: -1}
+	if s == "" {
+		return e
+	}
+	if inline {
+		e.offset, e.index = src.attribute(s)
+		return e
+	}
+	// Content of <style> elements is not escaped and follows a closing angle bracket
+	for from := src.pos; from < len(src.text); {
+		i := strings.Index(src.text[from:], s)
+		if i < 0 {
+			break
+		}
+		i += from
+		if i > 0 && src.text[i-1] == '>' {
+			e.offset = i
+			src.pos = i + len(s)
+			break
+		}
+		from = i + 1
+	}
+	return e
+}
+
+// attribute finds the next "style" attribute whose decoded value is s.
+// It returns the offset of the raw value and an index of its decoded offsets
+// if the raw value contains character references. offset is -1 if it can not be found.
+func (src *source) attribute(s string) (offset int, index []int) {
+	for from := src.pos; from < len(src.text); {
+		i := indexFold(src.text[from:], "style")
+		if i < 0 {
+			break
+		}
+		i += from
+		from = i + 1
+		if i == 0 || !isSpace(src.text[i-1]) {
+			continue
+		}
+		start, end := attributeValue(src.text, i+len("style"))
+		if start < 0 {
+			continue
+		}
+		raw := src.text[start:end]
+		if raw == s {
+			src.pos = end
+			return start, nil
+		}
+		if strings.IndexByte(raw, '&') >= 0 && html.UnescapeString(raw) == s {
+			src.pos = end
+			return start, unescapeIndex(raw)
+		}
+	}
+	return -1, nil
+}
+
+// attributeValue returns the offsets of the raw value of an attribute whose name ends at i.
+// start is -1 if the name is not followed by `=` and a value.
+func attributeValue(text string, i int) (start, end int) {
+	for i < len(text) && isSpace(text[i]) {
+		i++
+	}
+	if i == len(text) || text[i] != '=' {
+		return -1, -1
+	}
+	i++
+	for i < len(text) && isSpace(text[i]) {
+		i++
+	}
+	if i == len(text) {
+		return -1, -1
+	}
+	if q := text[i]; q == '"' || q == '\'' {
+		end := strings.IndexByte(text[i+1:], q)
+		if end < 0 {
+			return -1, -1
+		}
+		return i + 1, i + 1 + end
+	}
+	end = i
+	for end < len(text) && !isSpace(text[end]) && text[end] != '>' {
+		end++
+	}
+	return i, end
+}
+
+// unescapeIndex returns the offset within raw of every byte of html.UnescapeString(raw)
+// and the length of raw. Bytes of a decoded character reference map to its `&`.
+func unescapeIndex(raw string) []int {
+	index := make([]int, 0, len(raw)+1)
+	for i := 0; i < len(raw); {
+		n, decoded := 1, 1
+		if raw[i] == '&' {
+			if end := strings.IndexByte(raw[i:], ';'); end > 0 {
+				ref := raw[i : i+end+1]
+				if u := html.UnescapeString(ref); u != ref {
+					n, decoded = len(ref), len(u)
+				}
+			}
+		}
+		for ; decoded > 0; decoded-- {
+			index = append(index, i)
+		}
+		i += n
+	}
+	return append(index, len(raw))
+}
+
+// indexFold returns the index of the first case-insensitive instance of substr in s, or -1.
+func indexFold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// isSpace reports whether b is HTML whitespace.
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\f'
+}
+
+// location returns the Location of an offset within the CSS.
+// nil is returned if the CSS or the offset is unknown.
+func (src *source) location(offset int) *Location {
+	if src == nil || src.offset < 0 || offset < 0 {
+		return nil
+	}
+	if src.index != nil {
+		if offset >= len(src.index) {
+			return nil
+		}
+		offset = src.index[offset]
+	}
+	offset += src.offset
+	if offset > len(src.text) {
+		return nil
+	}
+	before := src.text[:offset]
+	line := strings.Count(before, "\n") + 1
+	column := utf8.RuneCountInString(before[strings.LastIndexByte(before, '\n')+1:]) + 1
+	return &Location{URL: src.url, Line: line, Column: column}
+}
+
+// findLiteral finds a color within the raw value of a declaration starting at from.
+// literal is the tokenized color, which may differ from the raw value in whitespace.
+// It returns the offset and the color as written, or -1 if it is not part of the value,
+// e.g. when it was resolved from var().
+func findLiteral(value, literal string, from int) (offset int, raw string) {
+	first := literal
+	fn := strings.IndexByte(literal, '(')
+	if fn >= 0 {
+		first = literal[:fn+1]
+	}
+	if first == "" || from > len(value) {
+		return -1, ""
+	}
+	for i := from; i < len(value); {
+		j := strings.Index(value[i:], first)
+		if j < 0 {
+			break
+		}
+		j += i
+		end := j + len(first)
+		if (j == 0 || !isIdentByte(value[j-1])) && (fn >= 0 || end == len(value) || !isIdentByte(value[end])) {
+			if fn >= 0 {
+				end = rawParen(value, end)
+			}
+			return j, value[j:end]
+		}
+		i = j + 1
+	}
+	return -1, ""
+}
+
+// rawParen returns the offset after the parenthesis closing a function whose arguments start at i.
+func rawParen(s string, i int) int {
+	depth := 1
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(s)
+}
+
+// isIdentByte reports whether a byte can be part of an identifier.
+func isIdentByte(b byte) bool {
+	return b == '-' || b == '_' || b == '#' || b >= 0x80 ||
+		b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
@@ -0,0 +1,87 @@
+package css
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/nochso/colourl/page"
+)
+
+func TestParsePage_Locations(t *testing.T) {
+	base, _ := url.Parse("https://example.com/index.html")
+	sheet, _ := url.Parse("https://example.com/style.css")
+	cml, err := ParsePage(&page.Page{
+		HTML: &page.File{URL: base, Body: `<html><head><style>
+/* a { color: red } */
+p { content: "color: blue"; color : HSL( 120 , 50%, 50% ) }
+</style></head>
+<body><p style="background: linear-gradient(#FFF 10%, cornflowerblue)">x</p>
+<div style="color: darkred; border-color: red">y</div></body></html>`},
+		CSS: []*page.File{{URL: sheet, Body: `:root { --main: #00ff00 }
+@media screen {
+  a, b { color: var(--main) }
+}
+@layer base { i { color: #abc } }`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct {
+		location string
+		literal  string
+	}{
+		{"https://example.com/index.html:3:37", "HSL( 120 , 50%, 50% )"},
+		{"https://example.com/index.html:5:45", "#FFF"},
+		{"https://example.com/index.html:5:55", "cornflowerblue"},
+		{"https://example.com/index.html:6:20", "darkred"},
+		{"https://example.com/index.html:6:43", "red"},
+		{"https://example.com/style.css:3:10", "#00ff00"},
+		{"https://example.com/style.css:3:10", "#00ff00"},
+		{"https://example.com/style.css:5:26", "#abc"},
+	}
+	if len(cml.Mentions) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cml.Mentions))
+	}
+	for i, cm := range cml.Mentions {
+		if cm.Location == nil {
+			t.Errorf("Expecting location %s for %s, got nil", exp[i].location, cm.Literal)
+			continue
+		}
+		if cm.Location.String() != exp[i].location || cm.Literal != exp[i].literal {
+			t.Errorf("Expecting %s at %s, got %s at %s", exp[i].literal, exp[i].location, cm.Literal, cm.Location)
+		}
+	}
+}
+
+func TestParseStylesheet_Locations(t *testing.T) {
+	cms := ParseStylesheet("a{color:red;\n\tbackground:#fff url(x.png)}\nb{ border: 1px solid rgba(0,0,0,.5) }")
+	exp := []string{"1:9", "2:13", "3:22"}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Location == nil || cm.Location.String() != exp[i] {
+			t.Errorf("Expecting location %s, got %v", exp[i], cm.Location)
+		}
+	}
+	if cms[2].Literal != "rgba(0,0,0,.5)" {
+		t.Errorf("Expecting literal rgba(0,0,0,.5), got %s", cms[2].Literal)
+	}
+}
+
+func TestParseHTML_LocationsEscaped(t *testing.T) {
+	cms, err := ParseHTML(`<p style="font-family: &quot;A&quot;; color: green">x</p>
+<p style='color:&#32;red'>y</p>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []string{"1:46", "2:22"}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Location == nil || cm.Location.String() != exp[i] {
+			t.Errorf("Expecting location %s, got %v", exp[i], cm.Location)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package css
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/nochso/colourl/page"
+)
+
+// Property names of colors found in a Web App Manifest.
+const (
+	PropManifestTheme      = "manifest:theme_color"
+	PropManifestBackground = "manifest:background_color"
+)
+
+// IsManifest reports whether a mention comes from a Web App Manifest.
+func (cm *ColorMention) IsManifest() bool {
+	return strings.HasPrefix(cm.Property, "manifest:")
+}
+
+// manifest contains the colors of a Web App Manifest.
+type manifest struct {
+	ThemeColor      string `json:"theme_color"`
+	BackgroundColor string `json:"background_color"`
+}
+
+// ParseManifest extracts the theme and background color of a Web App Manifest.
+// The selector of each ColorMention is the URL of the manifest.
+func ParseManifest(f *page.File) ([]*ColorMention, error) {
+	var m manifest
+	err := json.Unmarshal([]byte(f.Body), &m)
+	if err != nil {
+		return nil, err
+	}
+	var selector string
+	if f.URL != nil {
+		selector = f.URL.String()
+	}
+	var cms []*ColorMention
+	for _, v := range []struct{ value, property string }{
+		{m.ThemeColor, PropManifestTheme},
+		{m.BackgroundColor, PropManifestBackground},
+	} {
+		c, ok := parseAttributeColor(v.value, false)
+		if ok {
+			cm := c.mention(v.property, selector)
+			cm.Source = SourceManifest
+			cms = append(cms, cm)
+		}
+	}
+	return cms, nil
+}
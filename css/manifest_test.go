@@ -0,0 +1,54 @@
+package css
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/nochso/colourl/page"
+)
+
+func TestParseManifest(t *testing.T) {
+	u, _ := url.Parse("https://example.com/site.webmanifest")
+	cms, err := ParseManifest(&page.File{
+		Body: `{"name": "x", "theme_color": "#4285f4", "background_color": "rgb(255 255 255 / 50%)"}`,
+		URL:  u,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct{ property, hex string }{
+		{PropManifestTheme, "#4285f4"},
+		{PropManifestBackground, "#ffffff"},
+	}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Property != exp[i].property || cm.Color.Hex() != exp[i].hex {
+			t.Errorf("Expecting %s %s, got %s %s", exp[i].property, exp[i].hex, cm.Property, cm.Color.Hex())
+		}
+		if cm.Selector != u.String() {
+			t.Errorf("Expecting selector %s, got %s", u, cm.Selector)
+		}
+		if !cm.IsManifest() || cm.IsHTML() {
+			t.Errorf("Expecting ColorMention #%d to be from the manifest only", i)
+		}
+	}
+	if cms[1].Alpha != 0.5 {
+		t.Errorf("Expecting alpha of 0.5, got %f", cms[1].Alpha)
+	}
+}
+
+func TestParseManifest_Invalid(t *testing.T) {
+	_, err := ParseManifest(&page.File{Body: "<html>"})
+	if err == nil {
+		t.Error("Expecting an error for invalid JSON")
+	}
+	cms, err := ParseManifest(&page.File{Body: `{"theme_color": "not a color"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cms) != 0 {
+		t.Errorf("Expecting no ColorMentions, got %d", len(cms))
+	}
+}
@@ -0,0 +1,550 @@
+package css
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Matches reports whether a selector matches an element.
+//
+// Pseudo-elements match their originating element, e.g. `p::before` matches any <p>.
+// Dynamic and unknown pseudo-classes like `:hover` are assumed to match, as the
+// element could be in that state.
+func (sel Selector) Matches(n *html.Node) bool {
+	if n == nil || n.Type != html.ElementNode || len(sel) == 0 {
+		return false
+	}
+	return sel.matchesAt(len(sel)-1, n)
+}
+
+// matchesAt reports whether the compounds up to index i match an element,
+// starting with the last compound and following the combinators backwards.
+func (sel Selector) matchesAt(i int, n *html.Node) bool {
+	c := sel[i]
+	if !c.matches(n) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	switch c.Combinator {
+	case ">":
+		p := parentElement(n)
+		return p != nil && sel.matchesAt(i-1, p)
+	case "+":
+		s := previousElement(n)
+		return s != nil && sel.matchesAt(i-1, s)
+	case "~":
+		for s := previousElement(n); s != nil; s = previousElement(s) {
+			if sel.matchesAt(i-1, s) {
+				return true
+			}
+		}
+	default: // Descendant
+		for p := parentElement(n); p != nil; p = parentElement(p) {
+			if sel.matchesAt(i-1, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Select returns all elements of a HTML document matched by a selector in document order.
+func (sel Selector) Select(doc *html.Node) []*html.Node {
+	var nodes []*html.Node
+	for _, n := range elements(doc) {
+		if sel.Matches(n) {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// countElements sets the amount of elements matched by the selector of each ColorMention.
+// Mentions of rules matching no element are flagged as unused. Mentions of "style"
+// and HTML attributes apply to a single element. Selectors of at-rules like
+// @font-face or selectors that can not be parsed are not counted.
+func countElements(doc *html.Node, cms []*ColorMention) {
+	elems := elements(doc)
+	counts := map[string]int{}
+	for _, cm := range cms {
+		if cm.Inline || cm.IsHTML() {
+			cm.Elements = 1
+			continue
+		}
+		if cm.Source != SourceDocument && cm.Source != SourceImage || strings.HasPrefix(cm.Selector, "@") {
+			continue
+		}
+		count, ok := counts[cm.Selector]
+		if !ok {
+			count = -1
+			if list, err := ParseSelectors(cm.Selector); err == nil {
+				count = 0
+				for _, n := range elems {
+					if anyMatches(list, n) {
+						count++
+					}
+				}
+			}
+			counts[cm.Selector] = count
+		}
+		if count >= 0 {
+			cm.Elements = count
+			cm.Unused = count == 0
+		}
+	}
+}
+
+// elements returns an element and all of its descendant elements in document order.
+func elements(n *html.Node) []*html.Node {
+	var list []*html.Node
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			list = append(list, n)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return list
+}
+
+// matches reports whether all simple selectors of a compound match an element.
+func (c *Compound) matches(n *html.Node) bool {
+	if c.Tag != "" && c.Tag != "*" && !strings.EqualFold(c.Tag, n.Data) {
+		return false
+	}
+	for _, id := range c.IDs {
+		if attr(n, "id") != unescapeIdent(id) {
+			return false
+		}
+	}
+	if len(c.Classes) > 0 {
+		classes := strings.Fields(attr(n, "class"))
+		for _, class := range c.Classes {
+			if !contains(classes, unescapeIdent(class)) {
+				return false
+			}
+		}
+	}
+	for _, a := range c.Attrs {
+		if !a.matches(n) {
+			return false
+		}
+	}
+	for _, p := range c.Pseudos {
+		if !p.matches(n) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether an attribute selector matches an element.
+func (a *AttrSelector) matches(n *html.Node) bool {
+	var val string
+	found := false
+	for _, at := range n.Attr {
+		if strings.EqualFold(at.Key, a.Name) {
+			val, found = at.Val, true
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+	want := a.Value
+	if a.Insensitive {
+		val, want = strings.ToLower(val), strings.ToLower(want)
+	}
+	switch a.Operator {
+	case "":
+		return true
+	case "=":
+		return val == want
+	case "~=":
+		return contains(strings.Fields(val), want)
+	case "|=":
+		return val == want || strings.HasPrefix(val, want+"-")
+	case "^=":
+		return want != "" && strings.HasPrefix(val, want)
+	case "$=":
+		return want != "" && strings.HasSuffix(val, want)
+	case "*=":
+		return want != "" && strings.Contains(val, want)
+	}
+	return false
+}
+
+// matches reports whether a pseudo-class matches an element.
+// Pseudo-elements always match their originating element.
+func (p *Pseudo) matches(n *html.Node) bool {
+	if p.Element {
+		return true
+	}
+	switch p.Name {
+	case "is", "where":
+		return anyMatches(p.Selectors, n)
+	case "not":
+		// A selector depending on a dynamic state may or may not match, so neither can its negation be ruled out
+		for _, sel := range p.Selectors {
+			if !sel.dynamic() && sel.Matches(n) {
+				return false
+			}
+		}
+		return true
+	case "has":
+		return p.has(n)
+	case "root":
+		return parentElement(n) == nil
+	case "empty":
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode || c.Type == html.TextNode && c.Data != "" {
+				return false
+			}
+		}
+		return true
+	case "first-child":
+		return previousElement(n) == nil
+	case "last-child":
+		return nextElement(n) == nil
+	case "only-child":
+		return previousElement(n) == nil && nextElement(n) == nil
+	case "first-of-type", "last-of-type", "only-of-type":
+		before, after := typePosition(n)
+		return p.Name == "first-of-type" && before == 0 ||
+			p.Name == "last-of-type" && after == 0 ||
+			p.Name == "only-of-type" && before == 0 && after == 0
+	case "nth-child", "nth-last-child", "nth-of-type", "nth-last-of-type":
+		return p.nth(n)
+	case "link", "any-link":
+		return (n.Data == "a" || n.Data == "area") && hasAttr(n, "href")
+	case "checked":
+		return hasAttr(n, "checked") || hasAttr(n, "selected")
+	case "disabled":
+		return hasAttr(n, "disabled")
+	case "enabled":
+		return !hasAttr(n, "disabled")
+	case "required":
+		return hasAttr(n, "required")
+	case "optional":
+		return !hasAttr(n, "required")
+	}
+	// Dynamic states like :hover or :focus and unknown pseudo-classes
+	return true
+}
+
+// staticPseudos are pseudo-classes that can be matched against a document without user interaction.
+var staticPseudos = map[string]bool{
+	"root":             true,
+	"empty":            true,
+	"first-child":      true,
+	"last-child":       true,
+	"only-child":       true,
+	"first-of-type":    true,
+	"last-of-type":     true,
+	"only-of-type":     true,
+	"nth-child":        true,
+	"nth-last-child":   true,
+	"nth-of-type":      true,
+	"nth-last-of-type": true,
+	"link":             true,
+	"any-link":         true,
+	"checked":          true,
+	"disabled":         true,
+	"enabled":          true,
+	"required":         true,
+	"optional":         true,
+}
+
+// dynamic reports whether a selector contains a dynamic state like :hover or an
+// unknown pseudo-class, i.e. whether it may match depending on user interaction.
+func (sel Selector) dynamic() bool {
+	for _, c := range sel {
+		for _, p := range c.Pseudos {
+			if p.Element {
+				continue
+			}
+			switch p.Name {
+			case "is", "where", "not", "has":
+				for _, s := range p.Selectors {
+					if s.dynamic() {
+						return true
+					}
+				}
+			default:
+				if !staticPseudos[p.Name] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// has reports whether an element has a descendant or sibling matching the relative selectors of :has().
+func (p *Pseudo) has(n *html.Node) bool {
+	for _, sel := range p.Selectors {
+		var candidates []*html.Node
+		switch sel[0].Combinator {
+		case "+":
+			if s := nextElement(n); s != nil {
+				candidates = []*html.Node{s}
+			}
+		case "~":
+			for s := nextElement(n); s != nil; s = nextElement(s) {
+				candidates = append(candidates, s)
+			}
+		default: // Descendants, including children
+			candidates = elements(n)[1:]
+		}
+		for _, c := range candidates {
+			if sel.matchesRelative(n, c) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesRelative reports whether a relative selector like `> img` matches an element c relative to anchor.
+func (sel Selector) matchesRelative(anchor, c *html.Node) bool {
+	if !sel.Matches(c) {
+		return false
+	}
+	// Follow the combinators back to the first compound to find the element it matched
+	var first []*html.Node
+	var walk func(i int, n *html.Node)
+	walk = func(i int, n *html.Node) {
+		if !sel[i].matches(n) {
+			return
+		}
+		if i == 0 {
+			first = append(first, n)
+			return
+		}
+		switch sel[i].Combinator {
+		case ">":
+			if p := parentElement(n); p != nil {
+				walk(i-1, p)
+			}
+		case "+":
+			if s := previousElement(n); s != nil {
+				walk(i-1, s)
+			}
+		case "~":
+			for s := previousElement(n); s != nil; s = previousElement(s) {
+				walk(i-1, s)
+			}
+		default:
+			for p := parentElement(n); p != nil; p = parentElement(p) {
+				walk(i-1, p)
+			}
+		}
+	}
+	walk(len(sel)-1, c)
+	for _, f := range first {
+		switch sel[0].Combinator {
+		case ">":
+			if parentElement(f) == anchor {
+				return true
+			}
+		case "+":
+			if previousElement(f) == anchor {
+				return true
+			}
+		case "~":
+			for s := previousElement(f); s != nil; s = previousElement(s) {
+				if s == anchor {
+					return true
+				}
+			}
+		default:
+			for p := parentElement(f); p != nil; p = parentElement(p) {
+				if p == anchor {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// nth reports whether the position of an element matches the `An+B [of S]` arguments of :nth-child() and similar.
+func (p *Pseudo) nth(n *html.Node) bool {
+	a, b, ok := parseNth(p.Args)
+	if !ok {
+		return false
+	}
+	if len(p.Selectors) > 0 && !anyMatches(p.Selectors, n) {
+		return false
+	}
+	ofType := strings.HasSuffix(p.Name, "of-type")
+	counts := func(s *html.Node) bool {
+		if ofType {
+			return s.Data == n.Data
+		}
+		return len(p.Selectors) == 0 || anyMatches(p.Selectors, s)
+	}
+	pos := 1
+	next := previousElement
+	if strings.HasPrefix(p.Name, "nth-last") {
+		next = nextElement
+	}
+	for s := next(n); s != nil; s = next(s) {
+		if counts(s) {
+			pos++
+		}
+	}
+	// Is there an integer k >= 0 with a*k + b == pos?
+	if a == 0 {
+		return pos == b
+	}
+	k := (pos - b) / a
+	return (pos-b)%a == 0 && k >= 0
+}
+
+// parseNth parses the `An+B` syntax of :nth-child(), including `odd` and `even`.
+func parseNth(s string) (a, b int, ok bool) {
+	s = strings.ToLower(strings.Join(strings.Fields(s), ""))
+	switch s {
+	case "odd":
+		return 2, 1, true
+	case "even":
+		return 2, 0, true
+	}
+	i := strings.Index(s, "n")
+	if i < 0 {
+		b, err := strconv.Atoi(s)
+		return 0, b, err == nil
+	}
+	switch s[:i] {
+	case "", "+":
+		a = 1
+	case "-":
+		a = -1
+	default:
+		var err error
+		a, err = strconv.Atoi(s[:i])
+		if err != nil {
+			return 0, 0, false
+		}
+	}
+	if rest := s[i+1:]; rest != "" {
+		var err error
+		b, err = strconv.Atoi(rest)
+		if err != nil {
+			return 0, 0, false
+		}
+	}
+	return a, b, true
+}
+
+// typePosition returns the amount of siblings with the same tag name before and after an element.
+func typePosition(n *html.Node) (before, after int) {
+	for s := previousElement(n); s != nil; s = previousElement(s) {
+		if s.Data == n.Data {
+			before++
+		}
+	}
+	for s := nextElement(n); s != nil; s = nextElement(s) {
+		if s.Data == n.Data {
+			after++
+		}
+	}
+	return before, after
+}
+
+// anyMatches reports whether any selector of a list matches an element.
+func anyMatches(list []Selector, n *html.Node) bool {
+	for _, sel := range list {
+		if sel.Matches(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// parentElement returns the parent of an element or nil if it is the root element.
+func parentElement(n *html.Node) *html.Node {
+	if n.Parent == nil || n.Parent.Type != html.ElementNode {
+		return nil
+	}
+	return n.Parent
+}
+
+// previousElement returns the previous sibling element or nil if there is none.
+func previousElement(n *html.Node) *html.Node {
+	for s := n.PrevSibling; s != nil; s = s.PrevSibling {
+		if s.Type == html.ElementNode {
+			return s
+		}
+	}
+	return nil
+}
+
+// nextElement returns the next sibling element or nil if there is none.
+func nextElement(n *html.Node) *html.Node {
+	for s := n.NextSibling; s != nil; s = s.NextSibling {
+		if s.Type == html.ElementNode {
+			return s
+		}
+	}
+	return nil
+}
+
+// hasAttr reports whether an element has an attribute.
+func hasAttr(n *html.Node, key string) bool {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether a list of strings contains s.
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// unescapeIdent removes backslash escapes of an identifier like `md\:flex` or `\32 col`.
+func unescapeIdent(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 >= len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		j := i
+		for j < len(s) && j-i < 6 && strings.IndexByte("0123456789abcdefABCDEF", s[j]) >= 0 {
+			j++
+		}
+		if j == i { // Escaped character like `\:`
+			b.WriteByte(s[i])
+			continue
+		}
+		r, _ := strconv.ParseUint(s[i:j], 16, 32)
+		b.WriteRune(rune(r))
+		// A single whitespace ends a hex escape
+		if j < len(s) && s[j] == ' ' {
+			j++
+		}
+		i = j - 1
+	}
+	return b.String()
+}
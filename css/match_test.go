@@ -0,0 +1,111 @@
+package css
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nochso/colourl/page"
+	"golang.org/x/net/html"
+)
+
+const matchHTML = `<html><body>
+<header id="top" class="site header"><nav><a href="/" class="logo">Logo</a><a class="md:flex">Docs</a></nav></header>
+<ul>
+	<li>1</li><li class="item">2</li><li>3</li><li class="item">4</li><li>5</li>
+</ul>
+<form><input type="TEXT" disabled><input type="checkbox" required></form>
+<div lang="en-US"><p></p><img alt="x"></div>
+</body></html>`
+
+func TestSelector_Select(t *testing.T) {
+	doc, err := html.Parse(strings.NewReader(matchHTML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tests = []struct {
+		selector string
+		count    int
+	}{
+		{"*", 19},
+		{"a", 2},
+		{"header a", 2},
+		{"body > a", 0},
+		{"#top.site.header > nav > a.logo", 1},
+		{`.md\:flex`, 1},
+		{"li + li", 4},
+		{"li.item ~ li", 3},
+		{"li:first-child, li:last-child", 2},
+		{"li:nth-child(odd)", 3},
+		{"li:nth-child(2n)", 2},
+		{"li:nth-child(-n+2)", 2},
+		{"li:nth-last-child(1)", 1},
+		{"li:nth-child(1 of .item)", 1},
+		{"li:not(.item)", 3},
+		{":is(ul, nav) > :where(li, a)", 7},
+		{"div:has(> img)", 1},
+		{"div:has(p + img)", 1},
+		{"ul:has(img)", 0},
+		{"p:empty", 1},
+		{`input[type="text" i]:disabled`, 1},
+		{`input[type=text]`, 0},
+		{"input:required, input:enabled", 1},
+		{"[lang|=en]", 1},
+		{"a[href^='/']:hover::before", 1},
+		{":root", 1},
+		{"a:link", 1},
+		{"li:first-of-type, img:only-of-type", 2},
+		{"a:not(:hover)", 2},
+		{"a.logo:focus:not(:focus-visible)", 1},
+		{"li:not(.item, :hover)", 3},
+		{"li:not(:not(:hover))", 5},
+		{"li:not(:is(.item:active))", 5},
+	}
+	for _, tt := range tests {
+		list, err := ParseSelectors(tt.selector)
+		if err != nil {
+			t.Errorf("%s: %s", tt.selector, err)
+			continue
+		}
+		count := 0
+		for _, n := range elements(doc) {
+			if anyMatches(list, n) {
+				count++
+			}
+		}
+		if count != tt.count {
+			t.Errorf("%s: expected %d elements, got %d", tt.selector, tt.count, count)
+		}
+		if len(list) == 1 && len(list[0].Select(doc)) != tt.count {
+			t.Errorf("%s: expected Select to return %d elements", tt.selector, tt.count)
+		}
+	}
+}
+
+func TestParsePage_Unused(t *testing.T) {
+	cml, err := ParsePage(&page.Page{
+		HTML: &page.File{Body: `<div class="a" style="color: red"></div><div class="a"></div>`},
+		CSS:  []*page.File{{Body: `.a, .b { color: blue } @font-face { color: red }`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct {
+		selector string
+		elements int
+		unused   bool
+	}{
+		{"html > body > div.a:nth-child(1)", 1, false},
+		{".a", 2, false},
+		{".b", 0, true},
+		{"@font-face", 0, false},
+	}
+	if len(cml.Mentions) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cml.Mentions))
+	}
+	for i, cm := range cml.Mentions {
+		if cm.Selector != exp[i].selector || cm.Elements != exp[i].elements || cm.Unused != exp[i].unused {
+			t.Errorf("Expecting %s to match %d elements (unused %t), got %s matching %d (unused %t)",
+				exp[i].selector, exp[i].elements, exp[i].unused, cm.Selector, cm.Elements, cm.Unused)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package css
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// NamedColor is a color with a human-friendly name.
+type NamedColor struct {
+	Name  string
+	Color colorful.Color
+}
+
+// Dictionary is a list of named colors used for naming arbitrary colors.
+type Dictionary []NamedColor
+
+// Nearest returns the named color closest to c by CIEDE2000 distance.
+// The first color of the dictionary wins a tie. ok is false if the dictionary is empty.
+func (d Dictionary) Nearest(c colorful.Color) (nc NamedColor, distance float64, ok bool) {
+	for i, n := range d {
+		dist := c.DistanceCIEDE2000(n.Color)
+		if i == 0 || dist < distance {
+			nc, distance = n, dist
+		}
+	}
+	return nc, distance, len(d) > 0
+}
+
+var (
+	cssNames     Dictionary
+	cssNamesOnce sync.Once
+	extended     Dictionary
+	extendedOnce sync.Once
+)
+
+// CSSNames returns the named colors of CSS like `cornflowerblue` in alphabetical order.
+// Aliases like `aqua` and `cyan` are both included; the first one wins with Nearest.
+func CSSNames() Dictionary {
+	cssNamesOnce.Do(func() {
+		for name, hex := range names {
+			c, err := colorful.Hex(strings.ToLower(hex))
+			if err == nil {
+				cssNames = append(cssNames, NamedColor{name, c})
+			}
+		}
+		sort.Slice(cssNames, func(i, j int) bool { return cssNames[i].Name < cssNames[j].Name })
+	})
+	return cssNames
+}
+
+// ExtendedNames returns a dictionary of a few thousand descriptive color names like
+// "Absolute Zero" or "Signal yellow" in alphabetical order.
+// It is parsed on first use.
+func ExtendedNames() Dictionary {
+	extendedOnce.Do(func() {
+		for _, line := range strings.Split(extendedNames, "\n") {
+			parts := strings.SplitN(line, "\t", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			c, err := colorful.Hex(parts[1])
+			if err == nil {
+				extended = append(extended, NamedColor{parts[0], c})
+			}
+		}
+	})
+	return extended
+}
+
+// Nearest returns the name of the CSS named color closest to c by CIEDE2000 distance.
+func Nearest(c colorful.Color) (name string, distance float64) {
+	nc, distance, _ := CSSNames().Nearest(c)
+	return nc.Name, distance
+}
@@ -0,0 +1,47 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+func TestNearest(t *testing.T) {
+	tests := []struct {
+		hex  string
+		name string
+	}{
+		{"#6495ed", "cornflowerblue"},
+		{"#6496ee", "cornflowerblue"},
+		{"#00ffff", "aqua"},
+		{"#fe0102", "red"},
+		{"#808081", "gray"},
+		{"#010101", "black"},
+	}
+	for _, test := range tests {
+		c, _ := colorful.Hex(test.hex)
+		name, dist := Nearest(c)
+		if name != test.name {
+			t.Errorf("Expecting %s for %s, got %s", test.name, test.hex, name)
+		}
+		if test.hex == "#6495ed" && dist != 0 {
+			t.Errorf("Expecting distance 0 for an exact match, got %f", dist)
+		}
+	}
+}
+
+func TestExtendedNames(t *testing.T) {
+	d := ExtendedNames()
+	if len(d) < 2000 {
+		t.Errorf("Expecting at least 2000 extended names, got %d", len(d))
+	}
+	c, _ := colorful.Hex("#0048ba")
+	nc, _, ok := d.Nearest(c)
+	if !ok || nc.Name != "Absolute Zero" {
+		t.Errorf("Expecting Absolute Zero, got %s", nc.Name)
+	}
+	_, _, ok = Dictionary(nil).Nearest(c)
+	if ok {
+		t.Error("Expecting no match in an empty Dictionary")
+	}
+}
@@ -0,0 +1,79 @@
+package css
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/tdewolff/parse/css"
+)
+
+// lexed is a token of CSS and its byte offset.
+type lexed struct {
+	tt     css.TokenType
+	data   []byte
+	offset int
+}
+
+// offsets finds the byte offsets of the tokens returned by a css.Parser.
+// The parser drops whitespace and comments and lowercases names, so the same CSS
+// is lexed once and parser tokens are matched by type and case-insensitive data.
+type offsets struct {
+	tokens []lexed
+	// i is the index of the next token that has not been matched yet
+	i int
+}
+
+// newOffsets lexes CSS for finding the offsets of its tokens.
+func newOffsets(s string) *offsets {
+	l := css.NewLexer(strings.NewReader(s))
+	o := &offsets{}
+	pos := 0
+	for {
+		tt, data := l.Next()
+		if tt == css.ErrorToken {
+			return o
+		}
+		o.tokens = append(o.tokens, lexed{tt, data, pos})
+		pos += len(data)
+	}
+}
+
+// next returns the offsets of the next token matching the type and data of a parser token.
+// Tokens in between are skipped. start is -1 if there is no matching token.
+// Whitespace and tokens without data are not matched as the parser may create them.
+func (o *offsets) next(tt css.TokenType, data []byte) (start, end int) {
+	if tt == css.WhitespaceToken || len(data) == 0 {
+		return -1, -1
+	}
+	for i := o.i; i < len(o.tokens); i++ {
+		t := o.tokens[i]
+		if t.tt == tt && bytes.EqualFold(t.data, data) {
+			o.i = i + 1
+			return t.offset, t.offset + len(t.data)
+		}
+	}
+	return -1, -1
+}
+
+// skipValue moves past the value of a declaration, i.e. up to the next `;` or `}` that is not nested.
+// It is used for custom properties whose value is not split into tokens by the parser.
+func (o *offsets) skipValue() {
+	depth := 0
+	for ; o.i < len(o.tokens); o.i++ {
+		switch o.tokens[o.i].tt {
+		case css.LeftParenthesisToken, css.LeftBracketToken, css.LeftBraceToken, css.FunctionToken:
+			depth++
+		case css.RightParenthesisToken, css.RightBracketToken:
+			depth--
+		case css.RightBraceToken:
+			if depth == 0 {
+				return
+			}
+			depth--
+		case css.SemicolonToken:
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
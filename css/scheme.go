@@ -0,0 +1,73 @@
+package css
+
+import (
+	"strings"
+
+	"github.com/nochso/colourl/page"
+	"golang.org/x/net/html"
+)
+
+// UsedScheme returns the color scheme a browser uses for the page when the user prefers a certain scheme.
+// Pages that do not declare support for the preferred scheme keep using the first one they support.
+// Without any declaration the light scheme is used.
+func (cml *CML) UsedScheme(preferred string) string {
+	if len(cml.ColorScheme) == 0 {
+		return "light"
+	}
+	for _, s := range cml.ColorScheme {
+		if s == preferred {
+			return s
+		}
+	}
+	return cml.ColorScheme[0]
+}
+
+// declaredColorScheme returns the color schemes declared by `<meta name="color-scheme">`
+// or the `color-scheme` property of the root element. The last declaration wins.
+func declaredColorScheme(doc *html.Node, sheets []*page.File) []string {
+	var schemes []string
+	f := func(d *declaration) {
+		if strings.EqualFold(d.Property, "color-scheme") && isGlobalSelector(d.Selector) {
+			schemes = parseColorScheme(tokenString(d.Values))
+		}
+	}
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "meta" && strings.EqualFold(attr(n, "name"), "color-scheme") {
+			schemes = parseColorScheme(attr(n, "content"))
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	eachStyle(doc, func(s string, inline bool, selector string) {
+		eachDeclaration(s, inline, selector, f)
+	})
+	for _, sheet := range sheets {
+		eachDeclaration(sheet.Body, false, "", f)
+	}
+	return schemes
+}
+
+// parseColorScheme returns the schemes of a `color-scheme` value like `only light dark`.
+func parseColorScheme(s string) []string {
+	var schemes []string
+	for _, f := range strings.Fields(strings.ToLower(s)) {
+		switch f {
+		case "light", "dark":
+			schemes = append(schemes, f)
+		}
+	}
+	return schemes
+}
+
+// attr returns the value of a HTML attribute or an empty string.
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
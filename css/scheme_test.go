@@ -0,0 +1,58 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/nochso/colourl/page"
+)
+
+func TestParseStylesheet_LightDark(t *testing.T) {
+	cms := ParseStylesheet(`a { color: light-dark(#fff, rgb(0 0 0)) } b { color: red }`)
+	exp := []struct{ hex, scheme string }{
+		{"#ffffff", "light"},
+		{"#000000", "dark"},
+		{"#ff0000", ""},
+	}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Color.Hex() != exp[i].hex || cm.Scheme != exp[i].scheme {
+			t.Errorf("Expecting %s with scheme '%s', got %s with scheme '%s'", exp[i].hex, exp[i].scheme, cm.Color.Hex(), cm.Scheme)
+		}
+	}
+}
+
+func TestParsePage_ColorScheme(t *testing.T) {
+	var tests = []struct {
+		html, css string
+		exp       []string
+		used      string
+	}{
+		{``, ``, nil, "light"},
+		{`<meta name="color-scheme" content="dark light">`, ``, []string{"dark", "light"}, "dark"},
+		{``, `:root { color-scheme: only light }`, []string{"light"}, "light"},
+		{`<html style="color-scheme: dark">`, `.widget { color-scheme: light }`, []string{"dark"}, "dark"},
+	}
+	for ti, tt := range tests {
+		p := &page.Page{
+			HTML: &page.File{Body: tt.html},
+			CSS:  []*page.File{{Body: tt.css}},
+		}
+		cml, err := ParsePage(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cml.ColorScheme) != len(tt.exp) {
+			t.Fatalf("Test #%d expected color schemes %v, got %v", ti, tt.exp, cml.ColorScheme)
+		}
+		for i, s := range cml.ColorScheme {
+			if s != tt.exp[i] {
+				t.Errorf("Test #%d expected color schemes %v, got %v", ti, tt.exp, cml.ColorScheme)
+			}
+		}
+		if u := cml.UsedScheme("dark"); u != tt.used {
+			t.Errorf("Test #%d expected used scheme %s, got %s", ti, tt.used, u)
+		}
+	}
+}
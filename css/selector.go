@@ -0,0 +1,439 @@
+package css
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tdewolff/parse/css"
+)
+
+// Specificity of a selector: the amount of ID selectors, of class, attribute and
+// pseudo-class selectors and of type and pseudo-element selectors.
+type Specificity [3]int
+
+// Less reports whether s is less specific than o.
+func (s Specificity) Less(o Specificity) bool {
+	for i := range s {
+		if s[i] != o[i] {
+			return s[i] < o[i]
+		}
+	}
+	return false
+}
+
+// add returns the sum of two specificities.
+func (s Specificity) add(o Specificity) Specificity {
+	return Specificity{s[0] + o[0], s[1] + o[1], s[2] + o[2]}
+}
+
+// String returns a specificity like `(0,1,2)`.
+func (s Specificity) String() string {
+	return fmt.Sprintf("(%d,%d,%d)", s[0], s[1], s[2])
+}
+
+// Selector is a parsed complex selector like `nav > a.active`.
+type Selector []*Compound
+
+// Compound is a sequence of simple selectors like `a.active:hover` that all apply to the same element.
+type Compound struct {
+	// Combinator relating this compound to the previous one: " ", ">", "+" or "~".
+	// It is empty for the first compound unless it is a relative selector within :has().
+	Combinator string
+	// Tag name or `*`. Empty if omitted.
+	Tag     string
+	IDs     []string
+	Classes []string
+	Attrs   []*AttrSelector
+	Pseudos []*Pseudo
+}
+
+// AttrSelector is an attribute selector like `[type="text" i]`.
+type AttrSelector struct {
+	Name string
+	// Operator is empty if the attribute only has to exist, otherwise `=`, `~=`, `|=`, `^=`, `$=` or `*=`.
+	Operator string
+	Value    string
+	// Insensitive is true if the value is compared case-insensitively using the `i` flag.
+	Insensitive bool
+}
+
+// Pseudo is a pseudo-class like `:hover` or a pseudo-element like `::before`.
+type Pseudo struct {
+	// Name in lower case without colons or parenthesis, e.g. `nth-child`
+	Name string
+	// Element is true for pseudo-elements, including the legacy `:before` syntax.
+	Element bool
+	// Selectors are the arguments of :is(), :where(), :not() and :has(),
+	// or the `of S` part of :nth-child() and :nth-last-child().
+	Selectors []Selector
+	// Args are the raw arguments of functional pseudo-classes, e.g. `2n+1` of `:nth-child(2n+1)`.
+	Args string
+}
+
+// legacyPseudoElements can be written with a single colon.
+var legacyPseudoElements = map[string]bool{
+	"before":       true,
+	"after":        true,
+	"first-line":   true,
+	"first-letter": true,
+}
+
+// commaToken separates the selectors of a selector list.
+var commaToken = css.Token{TokenType: css.CommaToken, Data: []byte(",")}
+
+// ParseSelectors parses a selector list like `h1, h2:is(.title, #main)`.
+func ParseSelectors(s string) ([]Selector, error) {
+	return parseSelectorList(selectorTokens(s))
+}
+
+// selectorTokens splits a selector list into tokens.
+func selectorTokens(s string) []css.Token {
+	p := css.NewParser(strings.NewReader(s+"{}"), false)
+	var t []css.Token
+	for {
+		gt, _, _ := p.Next()
+		switch gt {
+		case css.QualifiedRuleGrammar:
+			t = append(append(t, copyTokens(p.Values())...), commaToken)
+		case css.BeginRulesetGrammar:
+			return append(t, copyTokens(p.Values())...)
+		default:
+			return nil
+		}
+	}
+}
+
+// parseSelectorList parses the tokens of a comma separated list of selectors.
+func parseSelectorList(t []css.Token) ([]Selector, error) {
+	var list []Selector
+	for _, part := range splitCommas(t) {
+		sel, err := parseSelector(part)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, sel)
+	}
+	return list, nil
+}
+
+// parseSelector parses the tokens of a single complex selector.
+func parseSelector(t []css.Token) (Selector, error) {
+	var sel Selector
+	c := &Compound{}
+	combinator := ""
+	// next starts a new compound once the current one is not empty
+	next := func() {
+		if c.isEmpty() {
+			return
+		}
+		sel = append(sel, c)
+		c = &Compound{}
+	}
+	for i := 0; i < len(t); i++ {
+		tt := t[i]
+		data := string(tt.Data)
+		// The combinator belongs to the compound started by this token
+		if c.isEmpty() && tt.TokenType != css.WhitespaceToken && tt.TokenType != css.CommentToken {
+			c.Combinator = combinator
+		}
+		switch tt.TokenType {
+		case css.WhitespaceToken, css.CommentToken:
+			if !c.isEmpty() {
+				next()
+				combinator = " "
+			}
+			continue
+		case css.DelimToken:
+			switch data {
+			case ">", "+", "~":
+				next()
+				c.Combinator = ""
+				combinator = data
+				continue
+			case ".":
+				if i+1 >= len(t) || t[i+1].TokenType != css.IdentToken {
+					return nil, errors.New("class selector is missing a name")
+				}
+				i++
+				c.Classes = append(c.Classes, string(t[i].Data))
+			case "*":
+				if !c.isEmpty() {
+					return nil, errors.New("universal selector must start a compound selector")
+				}
+				c.Tag = "*"
+			default:
+				return nil, fmt.Errorf("unexpected %q in selector", data)
+			}
+		case css.IdentToken:
+			if !c.isEmpty() {
+				return nil, fmt.Errorf("type selector %q must start a compound selector", data)
+			}
+			c.Tag = strings.ToLower(data)
+		case css.HashToken:
+			c.IDs = append(c.IDs, strings.TrimPrefix(data, "#"))
+		case css.LeftBracketToken:
+			end := closingBracket(t[i:])
+			if end < 0 {
+				return nil, errors.New("attribute selector is not closed")
+			}
+			a, err := parseAttrSelector(t[i+1 : i+end])
+			if err != nil {
+				return nil, err
+			}
+			c.Attrs = append(c.Attrs, a)
+			i += end
+		case css.ColonToken:
+			p, n, err := parsePseudo(t[i:])
+			if err != nil {
+				return nil, err
+			}
+			c.Pseudos = append(c.Pseudos, p)
+			i += n - 1
+		default:
+			return nil, fmt.Errorf("unexpected %q in selector", data)
+		}
+		combinator = ""
+	}
+	next()
+	if len(sel) == 0 {
+		return nil, errors.New("selector is empty")
+	}
+	return sel, nil
+}
+
+// isEmpty reports whether a compound does not contain any simple selectors yet.
+func (c *Compound) isEmpty() bool {
+	return c.Tag == "" && len(c.IDs) == 0 && len(c.Classes) == 0 && len(c.Attrs) == 0 && len(c.Pseudos) == 0
+}
+
+// closingBracket returns the index of the bracket closing the attribute selector at t[0].
+// It returns -1 if there is none.
+func closingBracket(t []css.Token) int {
+	for i, tt := range t {
+		if tt.TokenType == css.RightBracketToken {
+			return i
+		}
+	}
+	return -1
+}
+
+// attrOperators maps tokens of attribute selectors to their operator.
+var attrOperators = map[css.TokenType]string{
+	css.IncludeMatchToken:   "~=",
+	css.DashMatchToken:      "|=",
+	css.PrefixMatchToken:    "^=",
+	css.SuffixMatchToken:    "$=",
+	css.SubstringMatchToken: "*=",
+}
+
+// parseAttrSelector parses the tokens within the brackets of an attribute selector.
+func parseAttrSelector(t []css.Token) (*AttrSelector, error) {
+	var parts []css.Token
+	for _, tt := range t {
+		if tt.TokenType != css.WhitespaceToken {
+			parts = append(parts, tt)
+		}
+	}
+	if len(parts) == 0 || parts[0].TokenType != css.IdentToken {
+		return nil, errors.New("attribute selector is missing a name")
+	}
+	a := &AttrSelector{Name: strings.ToLower(string(parts[0].Data))}
+	if len(parts) == 1 {
+		return a, nil
+	}
+	if len(parts) < 3 || len(parts) > 4 {
+		return nil, fmt.Errorf("invalid attribute selector %q", tokenString(t))
+	}
+	if op, ok := attrOperators[parts[1].TokenType]; ok {
+		a.Operator = op
+	} else if parts[1].TokenType == css.DelimToken && string(parts[1].Data) == "=" {
+		a.Operator = "="
+	} else {
+		return nil, fmt.Errorf("invalid attribute selector operator %q", parts[1].Data)
+	}
+	switch parts[2].TokenType {
+	case css.StringToken:
+		a.Value = unquote(string(parts[2].Data))
+	case css.IdentToken, css.NumberToken:
+		a.Value = string(parts[2].Data)
+	default:
+		return nil, fmt.Errorf("invalid attribute selector value %q", parts[2].Data)
+	}
+	if len(parts) == 4 {
+		switch strings.ToLower(string(parts[3].Data)) {
+		case "i":
+			a.Insensitive = true
+		case "s":
+		default:
+			return nil, fmt.Errorf("invalid attribute selector flag %q", parts[3].Data)
+		}
+	}
+	return a, nil
+}
+
+// unquote removes the quotes of a string token.
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// parsePseudo parses a pseudo-class or pseudo-element starting with the colon at t[0].
+// It returns the amount of tokens it consists of.
+func parsePseudo(t []css.Token) (p *Pseudo, n int, err error) {
+	p = &Pseudo{}
+	n = 1
+	if len(t) > 1 && t[1].TokenType == css.ColonToken {
+		p.Element = true
+		n++
+	}
+	if n >= len(t) {
+		return nil, 0, errors.New("pseudo selector is missing a name")
+	}
+	name := strings.ToLower(string(t[n].Data))
+	switch t[n].TokenType {
+	case css.IdentToken:
+		p.Name = name
+		p.Element = p.Element || legacyPseudoElements[name]
+		return p, n + 1, nil
+	case css.FunctionToken:
+		p.Name = strings.TrimSuffix(name, "(")
+	default:
+		return nil, 0, fmt.Errorf("invalid pseudo selector %q", t[n].Data)
+	}
+	end := closingParen(t[n:])
+	if end < 0 {
+		return nil, 0, fmt.Errorf("pseudo selector %q is not closed", p.Name)
+	}
+	args := t[n+1 : n+end]
+	p.Args = strings.TrimSpace(tokenString(args))
+	switch p.Name {
+	case "is", "where", "not", "has":
+		p.Selectors, err = parseSelectorList(args)
+	case "nth-child", "nth-last-child":
+		// :nth-child(2n+1 of .item)
+		for i, tt := range args {
+			if tt.TokenType == css.IdentToken && strings.EqualFold(string(tt.Data), "of") {
+				p.Args = strings.TrimSpace(tokenString(args[:i]))
+				p.Selectors, err = parseSelectorList(args[i+1:])
+				break
+			}
+		}
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return p, n + end + 1, nil
+}
+
+// Specificity of a complex selector.
+func (sel Selector) Specificity() Specificity {
+	var s Specificity
+	for _, c := range sel {
+		s = s.add(c.Specificity())
+	}
+	return s
+}
+
+// Specificity of a compound selector.
+func (c *Compound) Specificity() Specificity {
+	s := Specificity{len(c.IDs), len(c.Classes) + len(c.Attrs), 0}
+	if c.Tag != "" && c.Tag != "*" {
+		s[2]++
+	}
+	for _, p := range c.Pseudos {
+		switch {
+		case p.Element:
+			s[2]++
+		case p.Name == "where":
+		case p.Name == "is", p.Name == "not", p.Name == "has":
+			// The most specific argument
+			s = s.add(maxSpecificity(p.Selectors))
+		case p.Name == "nth-child", p.Name == "nth-last-child":
+			s[1]++
+			s = s.add(maxSpecificity(p.Selectors))
+		default:
+			s[1]++
+		}
+	}
+	return s
+}
+
+// maxSpecificity returns the specificity of the most specific selector in a list.
+func maxSpecificity(list []Selector) Specificity {
+	var max Specificity
+	for _, sel := range list {
+		if s := sel.Specificity(); max.Less(s) {
+			max = s
+		}
+	}
+	return max
+}
+
+// String returns a normalized selector like `nav > a.active:hover`.
+func (sel Selector) String() string {
+	var b strings.Builder
+	for i, c := range sel {
+		switch {
+		case c.Combinator == " " && i > 0:
+			b.WriteString(" ")
+		case c.Combinator != "" && c.Combinator != " ":
+			if i > 0 {
+				b.WriteString(" ")
+			}
+			b.WriteString(c.Combinator + " ")
+		}
+		b.WriteString(c.String())
+	}
+	return b.String()
+}
+
+// String returns a compound selector like `a.active:hover`.
+func (c *Compound) String() string {
+	s := c.Tag
+	for _, id := range c.IDs {
+		s += "#" + id
+	}
+	for _, class := range c.Classes {
+		s += "." + class
+	}
+	for _, a := range c.Attrs {
+		s += "[" + a.Name
+		if a.Operator != "" {
+			s += a.Operator + fmt.Sprintf("%q", a.Value)
+		}
+		if a.Insensitive {
+			s += " i"
+		}
+		s += "]"
+	}
+	for _, p := range c.Pseudos {
+		s += ":"
+		if p.Element {
+			s += ":"
+		}
+		s += p.Name
+		if p.Args != "" || len(p.Selectors) > 0 {
+			s += "(" + p.argString() + ")"
+		}
+	}
+	return s
+}
+
+// argString returns the normalized arguments of a functional pseudo-class.
+func (p *Pseudo) argString() string {
+	list := make([]string, len(p.Selectors))
+	for i, sel := range p.Selectors {
+		list[i] = sel.String()
+	}
+	sels := strings.Join(list, ", ")
+	switch {
+	case len(p.Selectors) == 0:
+		return p.Args
+	case p.Name == "nth-child" || p.Name == "nth-last-child":
+		return p.Args + " of " + sels
+	}
+	return sels
+}
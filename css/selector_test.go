@@ -0,0 +1,76 @@
+package css
+
+import (
+	"testing"
+)
+
+func TestParseSelectors(t *testing.T) {
+	var tests = []struct {
+		in  string
+		out []string
+		spc []Specificity
+	}{
+		{"*", []string{"*"}, []Specificity{{0, 0, 0}}},
+		{"li", []string{"li"}, []Specificity{{0, 0, 1}}},
+		{"ul li", []string{"ul li"}, []Specificity{{0, 0, 2}}},
+		{"ul>li.red", []string{"ul > li.red"}, []Specificity{{0, 1, 2}}},
+		{"h1 + *[rel=up]", []string{"h1 + *[rel=\"up\"]"}, []Specificity{{0, 1, 1}}},
+		{"#s12:not(FOO)", []string{"#s12:not(foo)"}, []Specificity{{1, 0, 1}}},
+		{".foo :is(.bar, #baz)", []string{".foo :is(.bar, #baz)"}, []Specificity{{1, 1, 0}}},
+		{"a:where(#x, .y) ~ b", []string{"a:where(#x, .y) ~ b"}, []Specificity{{0, 0, 2}}},
+		{"p::before, a:after, a:hover", []string{"p::before", "a::after", "a:hover"}, []Specificity{{0, 0, 2}, {0, 0, 2}, {0, 1, 1}}},
+		{"li:nth-child(2n+1 of .item)", []string{"li:nth-child(2n+1 of .item)"}, []Specificity{{0, 2, 1}}},
+		{"li:nth-child(odd)", []string{"li:nth-child(odd)"}, []Specificity{{0, 1, 1}}},
+		{"div:has(> img)", []string{"div:has(> img)"}, []Specificity{{0, 0, 2}}},
+		{`input[type="text" i][disabled]`, []string{`input[type="text" i][disabled]`}, []Specificity{{0, 2, 1}}},
+		{"a[href^='http'], a[href$=pdf]", []string{`a[href^="http"]`, `a[href$="pdf"]`}, []Specificity{{0, 1, 1}, {0, 1, 1}}},
+	}
+	for _, tt := range tests {
+		list, err := ParseSelectors(tt.in)
+		if err != nil {
+			t.Errorf("%s: %s", tt.in, err)
+			continue
+		}
+		if len(list) != len(tt.out) {
+			t.Errorf("%s: expected %d selectors, got %d", tt.in, len(tt.out), len(list))
+			continue
+		}
+		for i, sel := range list {
+			if sel.String() != tt.out[i] {
+				t.Errorf("%s: expected selector %s, got %s", tt.in, tt.out[i], sel)
+			}
+			if sel.Specificity() != tt.spc[i] {
+				t.Errorf("%s: expected specificity %s, got %s", tt.in, tt.spc[i], sel.Specificity())
+			}
+		}
+	}
+}
+
+func TestParseSelectors_Invalid(t *testing.T) {
+	for _, in := range []string{"", "a..b", "a[", "a:is(", "a!", "@media"} {
+		_, err := ParseSelectors(in)
+		if err == nil {
+			t.Errorf("%s: expected an error", in)
+		}
+	}
+}
+
+func TestParseStylesheet_SelectorList(t *testing.T) {
+	cms := ParseStylesheet(`h1, .title:hover, #main > h2 { color: red }`)
+	exp := []struct {
+		selector string
+		spc      Specificity
+	}{
+		{"h1", Specificity{0, 0, 1}},
+		{".title:hover", Specificity{0, 2, 0}},
+		{"#main>h2", Specificity{1, 0, 1}},
+	}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Selector != exp[i].selector || cm.Specificity != exp[i].spc {
+			t.Errorf("Expecting %s %s, got %s %s", exp[i].selector, exp[i].spc, cm.Selector, cm.Specificity)
+		}
+	}
+}
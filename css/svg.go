@@ -0,0 +1,66 @@
+package css
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Property names of colors found in SVG presentation attributes, e.g. `<path fill="#fff">`.
+const (
+	PropSVGFill          = "svg:fill"
+	PropSVGStroke        = "svg:stroke"
+	PropSVGStopColor     = "svg:stop-color"
+	PropSVGFloodColor    = "svg:flood-color"
+	PropSVGLightingColor = "svg:lighting-color"
+	PropSVGColor         = "svg:color"
+)
+
+// svgAttributes maps SVG presentation attributes containing colors to property names.
+var svgAttributes = map[string]string{
+	"fill":           PropSVGFill,
+	"stroke":         PropSVGStroke,
+	"stop-color":     PropSVGStopColor,
+	"flood-color":    PropSVGFloodColor,
+	"lighting-color": PropSVGLightingColor,
+	"color":          PropSVGColor,
+}
+
+// parseSVGAttributes extracts colors from presentation attributes of a single SVG element.
+// Values like `none`, `currentColor` or `url(#gradient)` are ignored.
+func parseSVGAttributes(n *html.Node, selector string) []*ColorMention {
+	if n.Namespace != "svg" {
+		return nil
+	}
+	var cms []*ColorMention
+	for _, a := range n.Attr {
+		prop, ok := svgAttributes[a.Key]
+		if !ok {
+			continue
+		}
+		c, ok := parseAttributeColor(a.Val, false)
+		if ok {
+			cms = append(cms, c.mention(prop, selector))
+		}
+	}
+	return cms
+}
+
+// ParseSVG extracts colors from a SVG image, e.g. a logo.
+// Presentation attributes, "style" attributes and <style> elements are included.
+// Selectors start at the root <svg> element.
+func ParseSVG(s string) ([]*ColorMention, error) {
+	body := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(s), body)
+	if err != nil {
+		return nil, err
+	}
+	doc := &html.Node{Type: html.DocumentNode}
+	for _, n := range nodes {
+		doc.AppendChild(n)
+	}
+	vars := Vars{}
+	vars.collectHTML(doc)
+	return parseDocument(doc, vars, newSource(nil, s)), nil
+}
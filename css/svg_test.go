@@ -0,0 +1,76 @@
+package css
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/nochso/colourl/page"
+)
+
+func TestParseHTML_SVG(t *testing.T) {
+	cms, err := ParseHTML(`<body><svg>
+<linearGradient id="g"><stop offset="0" stop-color="#f00"/><stop offset="1" stop-color="blue"/></linearGradient>
+<path fill="url(#g)" stroke="#00ff00"/>
+<circle fill="none" stroke="currentColor"/>
+</svg><div fill="#fff"></div></body>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct{ property, selector, hex string }{
+		{PropSVGStopColor, "html > body > svg > linearGradient#g > stop:nth-child(1)", "#ff0000"},
+		{PropSVGStopColor, "html > body > svg > linearGradient#g > stop:nth-child(2)", "#0000ff"},
+		{PropSVGStroke, "html > body > svg > path", "#00ff00"},
+	}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Property != exp[i].property || cm.Selector != exp[i].selector || cm.Color.Hex() != exp[i].hex {
+			t.Errorf("Expecting %s %s %s, got %s %s %s", exp[i].property, exp[i].selector, exp[i].hex, cm.Property, cm.Selector, cm.Color.Hex())
+		}
+		if !cm.IsHTML() {
+			t.Errorf("Expecting ColorMention #%d to be from HTML", i)
+		}
+	}
+}
+
+func TestParseSVG(t *testing.T) {
+	cms, err := ParseSVG(`<?xml version="1.0"?>
+<svg xmlns="http://www.w3.org/2000/svg" style="color: #123">
+<style>:root { --brand: #ff6600 } .a { fill: var(--brand) }</style>
+<rect class="a" fill="#00f"/>
+</svg>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct{ property, selector, hex string }{
+		{"color", "svg", "#112233"},
+		{"fill", ".a", "#ff6600"},
+		{PropSVGFill, "svg > rect.a", "#0000ff"},
+	}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if cm.Property != exp[i].property || cm.Selector != exp[i].selector || cm.Color.Hex() != exp[i].hex {
+			t.Errorf("Expecting %s %s %s, got %s %s %s", exp[i].property, exp[i].selector, exp[i].hex, cm.Property, cm.Selector, cm.Color.Hex())
+		}
+	}
+}
+
+func TestParsePage_Logos(t *testing.T) {
+	u, _ := url.Parse("https://example.com/")
+	cml, err := ParsePage(&page.Page{
+		HTML:  &page.File{Body: `<svg><path fill="#f00"/></svg>`, URL: u},
+		Logos: []*page.File{{Body: `<svg><path fill="#00f"/></svg>`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cml.Mentions) != 2 {
+		t.Fatalf("Expecting 2 ColorMentions, got %d", len(cml.Mentions))
+	}
+	if cml.Mentions[0].Source != SourceDocument || cml.Mentions[1].Source != SourceLogo {
+		t.Error("Expecting only colors of the logo file to be tagged as logo")
+	}
+}
@@ -0,0 +1,208 @@
+package css
+
+import (
+	"strings"
+
+	"github.com/tdewolff/parse/css"
+)
+
+// Kinds of values containing multiple colors.
+const (
+	RoleGradient = "gradient"
+	RoleShadow   = "shadow"
+	RoleBorder   = "border"
+)
+
+// Role describes the position of a color within a value that can contain multiple colors,
+// e.g. a gradient stop, a shadow layer or the side of a border.
+type Role struct {
+	// Kind of value: RoleGradient, RoleShadow or RoleBorder
+	Kind string
+	// Index of the gradient stop or shadow layer, starting at 0
+	Index int
+	// Position of a gradient stop as written, e.g. `50%`
+	Position string
+	// Side of a border, e.g. `top` or `right left`. Empty if it applies to all sides.
+	Side string
+}
+
+// part is a single color within a declaration value.
+type part struct {
+	color
+	role *Role
+	// scheme is `light` or `dark` for arguments of light-dark()
+	scheme string
+	// literal is the color as tokenized, e.g. `#fff` or `rgb(1,2,3)`
+	literal string
+}
+
+// borderSides maps the amount of values of `border-color` to the sides they apply to.
+var borderSides = map[int][]string{
+	2: {"top bottom", "right left"},
+	3: {"top", "right left", "bottom"},
+	4: {"top", "right", "bottom", "left"},
+}
+
+// colorlessProperties never contain colors, but identifiers that look like
+// named colors, e.g. `font-family: Red Hat Display` or `animation-name: orange`.
+var colorlessProperties = map[string]bool{
+	"font":                 true,
+	"font-family":          true,
+	"content":              true,
+	"quotes":               true,
+	"grid-area":            true,
+	"grid-row":             true,
+	"grid-column":          true,
+	"grid-template-areas":  true,
+	"animation":            true,
+	"animation-name":       true,
+	"transition":           true,
+	"transition-property":  true,
+	"will-change":          true,
+	"counter-reset":        true,
+	"counter-increment":    true,
+	"list-style-type":      true,
+	"container-name":       true,
+	"view-transition-name": true,
+}
+
+// parseColors extracts all colors from the tokens of a declaration.
+// Colors are tagged with a Role depending on the property and the value they are part of.
+func parseColors(property string, t []css.Token) []part {
+	property = strings.ToLower(property)
+	switch {
+	case colorlessProperties[property]:
+		return nil
+	case property == "box-shadow" || property == "text-shadow":
+		var parts []part
+		for i, layer := range splitCommas(t) {
+			for _, p := range scanColors(layer) {
+				if p.role == nil {
+					p.role = &Role{Kind: RoleShadow, Index: i}
+				}
+				parts = append(parts, p)
+			}
+		}
+		return parts
+	case strings.HasPrefix(property, "border"):
+		parts := scanColors(t)
+		side := borderSide(property)
+		sides := borderSides[len(parts)]
+		for i := range parts {
+			if parts[i].role != nil {
+				continue
+			}
+			parts[i].role = &Role{Kind: RoleBorder, Side: side}
+			if property == "border-color" && sides != nil {
+				parts[i].role.Side = sides[i]
+			}
+		}
+		return parts
+	}
+	return scanColors(t)
+}
+
+// borderSide returns the side of a border property, e.g. `top` for `border-top-color`.
+func borderSide(property string) string {
+	side := strings.TrimPrefix(property, "border")
+	side = strings.TrimSuffix(side, "-color")
+	return strings.TrimPrefix(side, "-")
+}
+
+// scanColors returns every color found in a list of tokens.
+// Stops of gradient functions are tagged with their Role.
+func scanColors(t []css.Token) []part {
+	var parts []part
+	for i := 0; i < len(t); i++ {
+		if isGradient(t[i]) {
+			end := closingParen(t[i:])
+			if end < 0 {
+				break
+			}
+			parts = append(parts, gradientStops(t[i+1:i+end])...)
+			i += end
+			continue
+		}
+		if isFunction(t[i], "light-dark(") {
+			end := closingParen(t[i:])
+			if end < 0 {
+				break
+			}
+			parts = append(parts, lightDark(t[i+1:i+end])...)
+			i += end
+			continue
+		}
+		c, n, ok := parseColorAt(t[i:])
+		if ok {
+			parts = append(parts, part{color: c, literal: tokenString(t[i : i+n])})
+			i += n - 1
+		}
+	}
+	return parts
+}
+
+// isFunction reports whether a token starts a function with the given name, e.g. `var(`.
+func isFunction(t css.Token, name string) bool {
+	return t.TokenType == css.FunctionToken && strings.EqualFold(string(t.Data), name)
+}
+
+// lightDark returns the colors of both arguments of `light-dark(light, dark)` tagged with their color scheme.
+func lightDark(args []css.Token) []part {
+	var parts []part
+	for i, arg := range splitCommas(args) {
+		if i > 1 {
+			break
+		}
+		for _, p := range scanColors(arg) {
+			p.scheme = []string{"light", "dark"}[i]
+			parts = append(parts, p)
+		}
+	}
+	return parts
+}
+
+// isGradient reports whether a token starts a gradient function like `linear-gradient(`.
+func isGradient(t css.Token) bool {
+	return t.TokenType == css.FunctionToken && strings.HasSuffix(strings.ToLower(string(t.Data)), "gradient(")
+}
+
+// gradientStops returns the color stops found in the arguments of a gradient function.
+func gradientStops(args []css.Token) []part {
+	var parts []part
+	for _, arg := range splitCommas(args) {
+		for i := 0; i < len(arg); i++ {
+			c, n, ok := parseColorAt(arg[i:])
+			if !ok {
+				continue
+			}
+			pos := append(append([]css.Token{}, arg[:i]...), arg[i+n:]...)
+			parts = append(parts, part{color: c, literal: tokenString(arg[i : i+n]), role: &Role{
+				Kind:     RoleGradient,
+				Index:    len(parts),
+				Position: strings.TrimSpace(tokenString(pos)),
+			}})
+			break
+		}
+	}
+	return parts
+}
+
+// splitCommas splits tokens at commas that are not nested within functions or parenthesis.
+func splitCommas(t []css.Token) [][]css.Token {
+	var list [][]css.Token
+	depth, start := 0, 0
+	for i, tt := range t {
+		switch tt.TokenType {
+		case css.FunctionToken, css.LeftParenthesisToken:
+			depth++
+		case css.RightParenthesisToken:
+			depth--
+		case css.CommaToken:
+			if depth == 0 {
+				list = append(list, t[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(list, t[start:])
+}
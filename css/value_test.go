@@ -0,0 +1,77 @@
+package css
+
+import (
+	"fmt"
+	"testing"
+)
+
+var testsValue = []struct {
+	property string
+	in       string
+	out      []string
+}{
+	{"background-image", "linear-gradient(to right, #f00, #00f 50%)", []string{
+		"#ff0000 gradient 0 ",
+		"#0000ff gradient 1 50%",
+	}},
+	{"background", "url(a.png), radial-gradient(circle, red 10px 20px, transparent), #fff", []string{
+		"#ff0000 gradient 0 10px 20px",
+		"#000000 gradient 1 ",
+		"#ffffff",
+	}},
+	{"box-shadow", "0 0 1px #000, inset 1px 1px hsl(120deg 100% 50%)", []string{
+		"#000000 shadow 0 ",
+		"#00ff00 shadow 1 ",
+	}},
+	{"border-color", "red green blue white", []string{
+		"#ff0000 border top",
+		"#008000 border right",
+		"#0000ff border bottom",
+		"#ffffff border left",
+	}},
+	{"border-color", "red green", []string{
+		"#ff0000 border top bottom",
+		"#008000 border right left",
+	}},
+	{"border-left", "1px solid #000", []string{
+		"#000000 border left",
+	}},
+	{"border", "1px solid #000", []string{
+		"#000000 border ",
+	}},
+	{"color", "red", []string{
+		"#ff0000",
+	}},
+	{"font-family", "Red Hat Display, sans-serif", nil},
+	{"font", "12px Black Ops One", nil},
+	{"content", "orange", nil},
+	{"grid-area", "navy", nil},
+	{"animation-name", "Tomato", nil},
+}
+
+func (p part) String() string {
+	s := p.rgb.Hex()
+	if p.role == nil {
+		return s
+	}
+	switch p.role.Kind {
+	case RoleBorder:
+		return s + " " + p.role.Kind + " " + p.role.Side
+	}
+	return fmt.Sprintf("%s %s %d %s", s, p.role.Kind, p.role.Index, p.role.Position)
+}
+
+func TestParseColors(t *testing.T) {
+	for _, tt := range testsValue {
+		parts := parseColors(tt.property, tokens(tt.in))
+		if len(parts) != len(tt.out) {
+			t.Errorf("%s: %s: expected %d colors, got %d: %v", tt.property, tt.in, len(tt.out), len(parts), parts)
+			continue
+		}
+		for i, p := range parts {
+			if p.String() != tt.out[i] {
+				t.Errorf("%s: %s: expected '%s', got '%s'", tt.property, tt.in, tt.out[i], p)
+			}
+		}
+	}
+}
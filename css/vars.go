@@ -0,0 +1,151 @@
+package css
+
+import (
+	"strings"
+
+	"github.com/tdewolff/parse/css"
+)
+
+// maxVarDepth limits how deep chained custom properties are resolved.
+// This also stops cyclic definitions like `--a: var(--b); --b: var(--a)`.
+const maxVarDepth = 16
+
+// Var is the definition of a custom property, e.g. `:root { --primary: #f00 }`.
+type Var struct {
+	Name     string
+	Selector string
+	Value    []css.Token
+}
+
+// Vars maps names of custom properties to their definitions in order of appearance.
+type Vars map[string][]*Var
+
+// Define adds the definition of a custom property for a selector.
+// value is the raw value of the declaration, e.g. ` #f00`.
+func (v Vars) Define(name, selector, value string) {
+	v[name] = append(v[name], &Var{
+		Name:     name,
+		Selector: selector,
+		Value:    tokenize(value),
+	})
+}
+
+// Lookup returns the definition of a custom property that applies to a selector.
+// A definition for the exact selector is preferred, followed by definitions on
+// global selectors like `:root`. Otherwise the last definition is used.
+func (v Vars) Lookup(name, selector string) (*Var, bool) {
+	defs := v[name]
+	if len(defs) == 0 {
+		return nil, false
+	}
+	var global *Var
+	for i := len(defs) - 1; i >= 0; i-- {
+		if defs[i].Selector == selector {
+			return defs[i], true
+		}
+		if global == nil && isGlobalSelector(defs[i].Selector) {
+			global = defs[i]
+		}
+	}
+	if global != nil {
+		return global, true
+	}
+	return defs[len(defs)-1], true
+}
+
+// isGlobalSelector reports whether custom properties defined for s are inherited by the whole document.
+func isGlobalSelector(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case ":root", "html", "body", "*", "":
+		return true
+	}
+	return false
+}
+
+// collect adds all custom property definitions of a stylesheet or inline style.
+func (v Vars) collect(s string, inline bool, selector string) {
+	eachDeclaration(s, inline, selector, func(d *declaration) {
+		if d.Custom {
+			v.Define(d.Property, d.Selector, tokenString(d.Values))
+		}
+	})
+}
+
+// Resolve substitutes every `var()` in a list of tokens.
+// The name of the first custom property used is returned as well.
+// ok is false if a custom property is undefined and has no fallback.
+func (v Vars) Resolve(t []css.Token, selector string) (resolved []css.Token, name string, ok bool) {
+	return v.resolve(t, selector, 0)
+}
+
+func (v Vars) resolve(t []css.Token, selector string, depth int) (resolved []css.Token, name string, ok bool) {
+	if depth > maxVarDepth {
+		return nil, "", false
+	}
+	for i := 0; i < len(t); i++ {
+		if !isFunction(t[i], "var(") {
+			resolved = append(resolved, t[i])
+			continue
+		}
+		end := closingParen(t[i:])
+		if end < 0 {
+			return nil, "", false
+		}
+		varName, fallback, hasFallback := splitVar(t[i+1 : i+end])
+		if name == "" {
+			name = varName
+		}
+		var sub []css.Token
+		def, found := v.Lookup(varName, selector)
+		if found {
+			sub, _, found = v.resolve(def.Value, selector, depth+1)
+		}
+		if !found {
+			if !hasFallback {
+				return nil, "", false
+			}
+			sub, _, ok = v.resolve(fallback, selector, depth+1)
+			if !ok {
+				return nil, "", false
+			}
+		}
+		resolved = append(resolved, sub...)
+		i += end
+	}
+	return resolved, name, true
+}
+
+// splitVar splits the arguments of `var(--name, fallback)`.
+func splitVar(t []css.Token) (name string, fallback []css.Token, hasFallback bool) {
+	for i, tt := range t {
+		switch tt.TokenType {
+		case css.WhitespaceToken:
+		case css.CommaToken:
+			return name, t[i+1:], true
+		default:
+			if name == "" {
+				name = string(tt.Data)
+			}
+		}
+	}
+	return name, nil, false
+}
+
+// tokenize splits a raw declaration value into tokens.
+func tokenize(value string) []css.Token {
+	p := css.NewParser(strings.NewReader("x:"+value), true)
+	gt, _, _ := p.Next()
+	if gt != css.DeclarationGrammar {
+		return nil
+	}
+	return copyTokens(p.Values())
+}
+
+// copyTokens returns a deep copy of tokens whose data may be reused by the parser.
+func copyTokens(t []css.Token) []css.Token {
+	c := make([]css.Token, len(t))
+	for i, tt := range t {
+		c[i] = css.Token{TokenType: tt.TokenType, Data: append([]byte(nil), tt.Data...)}
+	}
+	return c
+}
@@ -0,0 +1,110 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/nochso/colourl/page"
+)
+
+var testsVars = []struct {
+	in  string
+	out []*ColorMention
+}{
+	{
+		`:root { --primary: #f00 } a { color: var(--primary) } b { color: var( --primary ) }`,
+		[]*ColorMention{
+			New(mustHex("#ff0000"), "color", "a"),
+			New(mustHex("#ff0000"), "color", "b"),
+		},
+	},
+	{
+		`a { color: var(--missing, var(--also-missing, blue)) } b { color: var(--missing) }`,
+		[]*ColorMention{
+			New(mustHex("#0000ff"), "color", "a"),
+		},
+	},
+	{
+		`:root { --base: 0 128 0; --link: rgb(var(--base)) } a { color: var(--link) }`,
+		[]*ColorMention{
+			New(mustHex("#008000"), "color", "a"),
+		},
+	},
+	{
+		`:root { --a: var(--b); --b: var(--a) } a { color: var(--a, red) } b { color: var(--a) }`,
+		[]*ColorMention{
+			New(mustHex("#ff0000"), "color", "a"),
+		},
+	},
+	{
+		`:root { --c: red } .dark { --c: black } .dark { color: var(--c) } a { color: var(--c) }`,
+		[]*ColorMention{
+			New(mustHex("#000000"), "color", ".dark"),
+			New(mustHex("#ff0000"), "color", "a"),
+		},
+	},
+}
+
+func TestParseStylesheet_Vars(t *testing.T) {
+	for ti, tt := range testsVars {
+		cms := ParseStylesheet(tt.in)
+		if len(cms) != len(tt.out) {
+			t.Fatalf("Test #%d expected %d ColorMentions, got %d", ti, len(tt.out), len(cms))
+		}
+		for ci, cm := range cms {
+			if cm.Color.Hex() != tt.out[ci].Color.Hex() {
+				t.Errorf("Test #%d expected hex %s for ColorMention #%d, got %s", ti, tt.out[ci].Color.Hex(), ci, cm.Color.Hex())
+			}
+			if cm.Selector != tt.out[ci].Selector {
+				t.Errorf("Test #%d expected selector %s for ColorMention #%d, got %s", ti, tt.out[ci].Selector, ci, cm.Selector)
+			}
+			if cm.Var == "" {
+				t.Errorf("Test #%d expected name of custom property for ColorMention #%d", ti, ci)
+			}
+		}
+	}
+}
+
+func TestParsePage_Vars(t *testing.T) {
+	p := &page.Page{
+		HTML: &page.File{Body: `<style>:root { --primary: #001122 }</style><div style="color: var(--primary)"></div>`},
+		CSS:  []*page.File{{Body: "a { --accent: var(--primary) } a:hover { background: var(--accent) }"}},
+	}
+	cml, err := ParsePage(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cml.Mentions) != 2 {
+		t.Fatalf("Expecting 2 ColorMentions, got %d", len(cml.Mentions))
+	}
+	for _, cm := range cml.Mentions {
+		if cm.Color.Hex() != "#001122" {
+			t.Errorf("Expecting resolved color #001122, got %s", cm.Color.Hex())
+		}
+	}
+	if cml.Mentions[1].Var != "--accent" {
+		t.Errorf("Expecting custom property --accent, got %s", cml.Mentions[1].Var)
+	}
+}
+
+func TestVars_Lookup(t *testing.T) {
+	v := Vars{}
+	if _, ok := v.Lookup("--x", "a"); ok {
+		t.Error("Lookup of undefined custom property must fail")
+	}
+	v.Define("--x", ".a", "red")
+	v.Define("--x", ".b", "blue")
+	def, _ := v.Lookup("--x", ".c")
+	if def.Selector != ".b" {
+		t.Errorf("Expecting last definition .b, got %s", def.Selector)
+	}
+	v.Define("--x", ":root", "green")
+	v.Define("--x", ".d", "black")
+	def, _ = v.Lookup("--x", ".c")
+	if def.Selector != ":root" {
+		t.Errorf("Expecting global definition :root, got %s", def.Selector)
+	}
+	def, _ = v.Lookup("--x", ".a")
+	if def.Selector != ".a" {
+		t.Errorf("Expecting exact definition .a, got %s", def.Selector)
+	}
+}
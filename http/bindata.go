@@ -69,7 +69,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _indexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x96\x51\x6f\xe2\x38\x14\x85\xdf\xf9\x15\x77\xad\x3e\x96\x58\x68\x5f\xb6\x55\x12\x69\xd5\xee\xb6\x33\x6a\x35\xa8\xd0\x8e\xe6\xa9\x32\xc9\x25\xb1\xea\xd8\x8c\x7d\x43\x40\x51\xfe\xfb\xc8\x49\xa0\x0c\x03\x55\xa7\x6a\x87\x97\x98\xe4\xf8\xf8\xbb\xf1\xe5\x98\xf0\xaf\xcb\x2f\x17\xd3\x6f\xe3\xff\x20\xa7\x42\xc5\x83\xd0\x5f\x40\x09\x9d\x45\x0c\x35\x83\x55\xa1\xb4\x8b\x58\x4e\xb4\x38\xe7\xbc\xaa\xaa\xa0\xfa\x3b\x30\x36\xe3\xa3\xb3\xb3\x33\xee\xc5\xcc\x4f\x42\x91\xc6\x03\x00\x80\xb0\x40\x12\x90\xe4\xc2\x3a\xa4\x88\xdd\x4f\xff\x1f\xfe\xc3\x76\x1f\x69\x51\x60\xc4\x96\x12\xab\x85\xb1\xc4\x20\x31\x9a\x50\x53\xc4\x2a\x99\x52\x1e\xa5\xb8\x94\x09\x0e\xdb\x2f\xa7\x20\xb5\x24\x29\xd4\xd0\x25\x42\x61\x34\xda\x18\x91\x24\x85\x71\x62\x94\x29\xad\x82\xeb\xe9\x74\x0c\xff\x8e\x3f\x85\xbc\xbb\xdf\x69\x94\xd4\x4f\x60\x51\x45\xcc\xd1\x5a\xa1\xcb\x11\x89\x41\x6e\x71\xee\xef\x08\x92\x09\xef\x0d\x82\xc4\x39\xc6\xe3\x41\xc8\xbb\x32\xc2\x99\x49\xd7\xf1\x20\x4c\xe5\x12\x12\x25\x9c\x8b\x58\x82\x9a\xd0\x6e\x96\xdf\x79\x90\x59\x99\x3e\x3e\x5a\x53\xc1\x76\x34\x1c\xaa\xac\x57\x1e\x56\x4b\xc2\xa2\x2d\x5b\x48\xbd\x35\xdd\xca\xf3\xd1\xfe\xa2\xa1\xe8\xb1\xfd\x26\xb8\x73\xce\x33\x49\x79\x39\x0b\x12\x53\x70\x6d\x92\xdc\x99\x4d\x25\x2c\xbe\xe8\x06\x21\x17\x71\xc8\xf3\xd1\x9e\xf7\xdc\xd8\x02\x0a\xa4\xdc\xa4\x11\xcb\x90\xf6\xd6\xde\xc7\x9d\x4b\x54\xe9\x30\xb3\xa6\x5c\x1c\x50\x76\x6f\x59\xcc\x50\xc5\xf7\x77\x37\x21\xef\x86\x87\x65\x52\x2f\x4a\x02\x5a\x2f\x30\x62\x84\x2b\x62\x7d\x1b\x78\x66\x58\x0a\x55\x62\xc4\xea\x3a\xb8\xbf\xbb\x69\x1a\xbf\x15\xbf\x18\xf0\x54\x2e\xe3\xc1\xfb\xd0\x7e\xf5\xbd\xf5\x7a\x5e\x5d\x16\x33\xb4\x1b\xe2\x6a\x97\xf7\xb3\x99\x05\xad\xdb\x1f\xa0\xbe\x46\x99\xe5\xf4\x56\xec\x7c\x1f\xbb\xb3\x7b\x91\xfb\x5d\xb0\x6f\xc5\x0a\x7c\x77\x5a\xf7\x56\xf4\x42\xac\xf6\xe1\x6f\xc5\xea\xe3\xc9\x27\x3e\x36\x5e\x86\x76\xa8\x30\xa1\x9e\xb3\x8d\x99\x23\x96\xfe\x53\xd7\x56\xe8\x0c\xe1\xe4\x09\xd7\xa7\x70\xd2\x56\x04\xe7\x11\x04\x63\x21\xfd\x0f\xdd\x35\xcd\xd1\xb9\xa1\x59\x90\x34\xba\xae\xe5\x1c\xf0\x7b\x6b\x01\x27\x41\x4b\x08\x4d\x03\x1d\x07\xa6\x75\x8d\x3a\x6d\x9a\xb8\xae\xbd\xa2\x69\x42\xde\xcd\x7b\x09\xaa\x9d\x71\xb8\x3c\xde\xf9\x7e\xec\x6b\x7e\x7d\x5b\xb8\x72\x56\x48\xda\xf6\xc2\xa5\x15\x15\x4c\x1e\xae\xd8\x66\xc9\x59\x49\x64\xf4\x70\x61\x65\x21\xec\xfa\x50\xb6\xbd\x27\xf6\x26\x94\xeb\x3a\x98\x3c\x5c\x75\xc9\x15\x4f\x1e\xae\xa0\x0d\x43\xf1\x1b\x75\x75\x81\xf8\xdc\xe1\xcf\x76\xaf\xa9\x20\xe4\x3e\xd5\x77\xce\x9b\x9f\x25\xc7\x8e\x9f\xdd\x03\xed\x99\xaa\xc8\xc0\xd9\xe4\x18\xc5\x8e\x75\x3f\xdc\x5e\xfa\x23\x93\x77\x7f\x22\x7e\x04\x00\x00\xff\xff\xe9\x71\x3e\x51\x55\x08\x00\x00")
+var _indexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\x03\xd5\x58\xdf\x6f\xdb\x36\x10\x7e\xcf\x5f\xc1\xa9\x79\x8c\xa5\x18\x41\x8b\xb9\x90\x0c\x14\x4d\xd7\x76\x68\xbb\x60\x71\x37\xec\xa9\xa0\xa4\xb3\x45\x84\x22\x3d\x92\x8a\x6c\x08\xfa\xdf\x77\xd4\x2f\x2b\x99\x9d\xd8\x6a\x1c\x20\x7e\x11\x2d\xdd\x1d\xbf\xef\xee\x48\x1e\xcf\xff\xe5\xf2\x8f\xf7\xb3\x7f\xae\x3e\x90\xc4\xa4\x7c\x7a\xe2\xdb\x07\xe1\x54\x2c\x02\x07\x84\x43\x56\x29\x17\x3a\x70\x12\x63\x96\x6f\x3d\x2f\xcf\x73\x37\xbf\x70\xa5\x5a\x78\xe3\xc9\x64\xe2\x59\x61\xc7\x2a\x01\x8d\xa7\x27\x04\x7f\x7e\x0a\x86\x92\x28\xa1\x4a\x83\x09\x9c\xef\xb3\xdf\x46\xbf\x3a\xfd\x4f\x82\xa6\x10\x38\xb7\x0c\xf2\xa5\x54\xc6\x21\x91\x14\x06\x04\x8a\xe6\x2c\x36\x49\x10\xc3\x2d\x8b\x60\x54\xfd\x39\x23\x4c\x30\xc3\x28\x1f\xe9\x88\x72\x08\xc6\xad\x21\xc3\x0c\x87\x69\x24\xb9\xcc\x14\x27\x9f\x66\xb3\x2b\xf2\xee\xea\xb3\xef\xd5\xef\x6b\x19\xce\xc4\x0d\x51\xc0\x03\x47\x9b\x35\x07\x9d\x00\xe0\x6c\x89\x82\xb9\x7d\x43\x0d\x8b\xbc\xc6\x80\x1b\x69\xed\x78\xc8\xc2\xab\x69\xf8\xa1\x8c\xd7\xf8\x88\xd9\x2d\x89\x38\xd5\x48\x3f\x42\x84\xa0\xda\xe9\x7b\x1f\x16\x8a\xc5\x3f\x7e\x28\x99\x93\x6e\x34\x1a\xf1\x45\x23\xb9\x5d\x9a\x19\x48\x2b\xda\x94\x89\xce\x68\x27\x9e\x8c\xef\x4f\xea\xd3\x06\xb6\x0d\x82\xc6\x28\x2c\x98\x49\xb2\xd0\x8d\x64\xea\x09\x19\x25\x5a\xb6\x4c\x9c\xe9\xfb\x7a\xe0\x7b\x74\x8a\x74\xc6\xf7\x6c\xcf\xa5\x4a\x09\x46\x21\x91\x31\x82\x41\x7f\xdc\xfd\x7e\x1f\xee\x9c\x01\x8f\x47\x0b\x25\xb3\xe5\x16\xc9\xda\xcb\x34\x04\x3e\xfd\xfe\xe7\x17\xdf\xab\x87\xdb\xc5\x98\x58\x66\x86\x98\xf5\x12\x43\x6f\x60\x85\x81\xa8\xd3\xc0\x62\x26\xb7\x94\x67\x38\x2e\x0a\x17\xed\x94\xa5\x0d\xc5\xff\x0c\x78\x08\x6b\x7a\xf2\x34\x68\xff\xb6\xb9\xb5\x3f\x5e\x91\xa5\x21\x86\xa1\x41\x9c\xf7\xf1\xfe\x2e\x43\xb7\xb2\xf6\x0c\xa8\x3f\x01\x5b\x24\x66\x28\xec\xe4\x3e\xec\xda\xdc\x83\xb8\x9f\x04\xf6\x57\xba\x22\x36\x3b\x95\x1e\x0a\x3d\xa5\xab\xfb\xe0\xd1\xe8\xf1\x91\x5f\xdb\x6d\xe3\x61\xd0\x1a\x38\x44\xa6\xc1\x59\x6d\x33\x3b\x4c\xda\x5f\x51\x28\xdc\x55\x81\x9c\xde\xc0\xfa\x8c\x9c\x56\x8c\xc8\xdb\x80\xb8\x57\xb8\x0f\xe0\x42\xd7\x65\xb9\x53\xd7\x97\x4b\xc3\xa4\x28\x0a\x36\x27\xf0\x6f\x65\x82\x9c\xba\x15\x42\x52\x96\xa4\xc6\x01\x71\x51\x80\x88\xcb\x72\x5a\x14\x56\xa2\x2c\x7d\xaf\xd6\x7b\x08\x54\xa5\xb1\x9d\x9e\x57\xdb\x3d\xb2\x9b\x23\xa9\x0e\x72\xb3\x95\x1f\xe2\xe6\x6a\xa2\x21\x5e\xb6\x7a\x2f\xdd\xcb\xf6\x5c\x50\x44\x47\x09\xa4\x87\x39\xdb\x2a\xec\xe1\x6d\xf4\x92\x95\xdc\xc3\xbb\xbd\xa5\x8c\x6b\xb8\xf5\xb5\x5b\x39\xda\x9a\xd8\xee\x69\x94\x42\xf1\x4a\x07\x5f\x72\x0d\x65\x49\x39\x6f\x76\x96\x46\xec\xe5\xc4\xc1\x3a\xf7\x91\xfd\xf0\x4e\x18\x2a\xf9\x7d\xa2\xf0\x8d\xfe\x64\x0c\xd0\x00\x13\x8b\xbd\x63\x20\xa4\x80\x97\xe4\x7d\x9e\x69\xdc\x69\x89\x91\x1c\xd0\x63\xd1\x23\x4b\x61\xf7\x99\xd4\x19\x70\x08\xfa\x2b\x70\xce\xf1\x49\x57\x81\x33\x76\x08\x4e\xb0\xc4\x17\xee\xf9\xb8\x7f\x6a\x7d\xb4\xe0\xdc\x59\xab\xf6\x0c\xe7\x2e\x13\x44\x53\x93\x29\x6a\x83\x32\x94\xe7\xc6\xc2\xbe\x44\xed\xf1\x3c\x53\x2c\x75\x11\xc0\x75\xa7\x7d\x7c\xbe\x5f\x6c\x39\x23\x40\x6b\x12\x52\x11\x0f\xe5\xcb\x91\xe4\x10\xa6\xdd\xec\xdb\x89\x3e\x3a\xab\x2d\x71\x0e\x9d\x95\xae\x1e\x99\xf5\x29\xdd\x7b\xc9\xf0\xce\x84\x89\x8b\x4b\x87\xe4\x09\xde\x60\xbc\x90\xd3\xe8\x66\xa8\x9f\xf1\x0a\xa0\xec\x71\x71\x30\xeb\x0f\x8d\xe2\xf3\x2c\xa0\xb8\x65\x1d\x82\xc9\x01\xc4\x4f\x56\xb2\xad\xb9\x21\x19\xd6\x06\xe0\x19\x43\x8d\x17\x45\xc5\xa2\x03\x8e\xa9\x5a\x61\x48\x6d\xf6\xb5\xd2\x3c\xbc\x36\xab\xf5\x5e\x7a\x71\xf6\x8e\xe7\x74\xad\x09\x13\x11\xcf\xe2\x03\xce\xa4\xfe\x4d\xba\x51\x76\xc8\x12\xd7\x25\x24\x92\xc7\xa0\x02\xe7\xd5\x9b\x37\x17\x17\x93\xc9\x19\x79\x35\x9f\xbf\x7e\x7d\x7e\xde\xcf\xae\xcf\xb5\xc6\xf1\x13\xea\x2e\x3d\x62\xaa\x2a\xaf\x5a\x49\xfb\x53\xc5\xd2\x30\xba\x09\xe5\xaa\x3b\x80\xab\xba\xb4\x65\x33\xae\x6b\x98\xcd\x82\x69\xb8\xcd\xac\x14\x26\x47\xa5\xdd\xe5\xc6\x91\xe9\xee\x4f\x4a\x67\x61\xca\x4c\xc7\xe2\x52\xd1\x9c\x5c\xff\xf5\xd1\x69\xa7\x0c\x33\x63\xa4\x18\x2d\x91\x11\x55\xeb\x6d\xcd\x9a\xa7\x84\xdd\x76\x99\x30\x35\x10\x44\xdd\x8a\x99\xe2\x88\x54\xdd\x1d\x7a\x00\xaf\x3a\x2f\x37\x99\xb6\x31\xb7\x0f\x03\xdf\xb3\x6d\xaa\x5e\x03\xed\xae\xc8\xae\x7e\x5a\xbf\x43\xb7\x41\x95\x2e\x88\x56\xd1\x2e\x14\x3d\xd3\xcd\xb0\x7b\x34\x3d\x40\xaf\xee\x8a\xfe\x07\x05\x01\x1a\x79\x26\x15\x00\x00")
 
 func indexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -84,12 +84,12 @@ func indexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "index.html", size: 2133, mode: os.FileMode(436), modTime: time.Unix(1465254045, 0)}
+	info := bindataFileInfo{name: "index.html", size: 5414, mode: os.FileMode(436), modTime: time.Unix(1792252427, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _staticColourlCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\x4d\x6f\xf3\x44\x10\xc7\xef\xfe\x14\xa3\xe7\x51\x95\x12\xc5\x2f\x69\x92\x86\x3a\x42\x02\x01\x07\x04\x15\x87\x0a\x0e\x20\x54\xad\xbd\x63\x67\xdb\xf5\xee\x6a\x77\x4d\x1d\x50\xbe\x3b\x5a\xbf\xc7\x75\x2a\x0e\x8f\x72\xca\xcc\xf8\x3f\x6f\xbf\xd9\x44\xd2\x13\xfc\xeb\x01\x00\x64\x52\x58\x3f\x23\x05\xe3\xa7\x18\x16\xbf\x2a\x14\xf0\x44\x84\x59\xac\x60\xf1\x03\xbe\x90\xdf\xcb\xfe\xef\x13\xe6\x12\xe1\xb7\x9f\x16\x2b\x30\x44\x18\xdf\xa0\x66\xd9\xa1\x16\x49\x25\x97\x3a\x86\xcf\x9b\xcd\xa6\x31\x28\x42\x29\x13\x79\x0c\x11\xac\x35\x16\x07\xef\xec\x79\xa4\xcd\xd8\x05\x47\x3f\xee\xbe\x7b\xd8\x36\xae\xf8\x28\xff\x46\x3d\x0d\xc0\xfd\x3e\x69\x02\x82\xa4\xb4\x56\x8a\x26\x6c\x05\x97\xff\x98\x50\xa5\xfd\xd3\x9e\x14\x7e\xf3\xa9\xf1\x7c\xfa\x6b\xce\xa7\xd1\xa0\x9d\x77\x99\x32\x29\x58\xef\x6b\xeb\x48\x48\xfa\x9a\x6b\x59\x0a\xea\xcf\x94\xc4\x8a\xbc\x8d\x2b\x48\xe5\xbf\x31\x6a\x8f\x31\xac\xa3\xe8\xa6\x29\x38\x95\xc2\x12\x26\x7a\xb1\x36\xe0\x61\x77\x73\x98\x7e\x74\x1f\x45\xaa\xea\xac\x3a\x67\x22\x06\x52\x5a\xd9\x58\x32\x2e\x89\x8d\x81\x63\x66\x5b\x61\x14\xb6\x57\xb5\x58\x59\x9f\x70\x96\x8b\x18\x1a\x47\x1d\xa4\x34\xae\x20\x95\x14\x67\x97\x3c\xda\x2b\x3c\x4a\x21\xdd\x72\x7f\x61\x09\x6a\x62\x99\x14\xbd\xe9\x7b\x29\x8c\xe4\xc4\xed\xbe\x90\x42\x1a\x45\x52\x1c\x2f\xa3\x5b\xc3\xfc\x02\x66\x47\x3f\x3f\xf4\x0f\xc6\xdd\x22\x52\xbb\xa5\xa6\xa8\x63\x88\xc6\x7f\x7d\x4d\x28\x2b\x4d\x0c\x51\xb0\xa9\x39\x1b\xf3\x93\x65\x1d\x9e\xa5\x36\xce\xa2\x24\x6b\x46\xe4\x8c\x94\x19\xc5\xc9\x29\x06\x26\x38\x13\xe8\x27\x5c\xa6\xaf\x87\x61\x5c\x86\xfd\x83\x31\xac\x83\x6d\xaf\x3b\x40\x1d\x6c\xb1\x80\x28\xd8\xb5\x64\x87\xcb\xa3\xb5\x2a\x0e\x43\x37\x72\x85\x22\x60\x32\xb4\x52\x27\x28\x52\xc9\xdd\x27\xa1\x42\x11\x62\xf1\xf3\xe3\x1f\xd5\x32\xf4\x32\xa9\x0b\x08\x32\x86\x9c\xfa\xae\x61\xd5\x0e\xa0\xaf\x28\xe3\x38\xe1\x21\x72\x87\x74\xa7\xaa\xae\xfb\x11\x3d\xbb\x86\x9e\xb3\x37\xa3\xcb\x49\x82\xbc\x63\x80\x63\x15\xc3\xfa\xf0\x8e\x1b\xcd\xf2\xa3\x9d\xa6\x5b\x07\x77\xda\xf5\xd8\x25\x0c\x97\x7d\xf7\x4d\x19\xcb\xb0\xb6\xd7\x1a\xbe\x41\x9e\x5d\x00\xf8\xbe\x94\x7a\xf1\xab\x19\x87\x41\x8e\xa9\xbd\x28\x72\xd3\x1c\x58\xfd\x45\xeb\x5f\xd5\x25\x13\x8d\xdd\x2b\xd2\x97\x33\x5a\xd0\x78\x6f\xbd\xb1\xe3\xc6\x48\xce\x28\xac\x55\x05\x9f\xd3\x34\x3d\x4c\x90\x1b\xe3\x92\xc8\xca\xa9\xd4\xea\x2d\x66\x89\xac\x66\xb1\x6b\xa9\x3b\x7b\xde\xb7\x05\x52\x46\xe0\x76\xb4\x99\xed\xd7\x91\xaa\xbe\x6a\xeb\xbd\xe8\xa6\xb5\x8d\xde\x85\xe6\xe1\x70\x86\xb3\xd7\xf6\x32\x0f\xc9\x15\x50\xba\xe1\xf9\x94\x69\x4c\xdd\x1d\xc7\xee\x0e\xca\x42\x0c\xfe\x31\x4c\xf7\x03\x4b\x57\x13\x8e\xe9\x99\x3c\xea\x11\xec\x06\x81\xb1\xf6\x3a\x72\xf6\x81\x9b\x29\x23\x75\x8d\xc6\x12\x6d\xbb\xe4\xee\x41\xc9\x35\xa3\xcf\xcf\x5a\xbe\x5d\xbf\x84\x8f\x9a\x7b\x29\x8d\x65\xd9\xc9\x77\x6f\x2e\x0a\x3b\x90\x38\x64\x67\x16\x0b\x73\x81\x68\x9b\xd4\x39\xe6\x2e\xa4\x6f\xd6\x9d\x5d\x77\x03\x33\x2c\xe1\xde\xfd\xdc\x39\x0c\x10\x10\xce\x81\x08\x0a\xb7\x05\x13\xb3\x30\x0c\x0d\xfb\xbe\x29\x46\x23\x9e\x76\xa9\xe5\xdb\x68\x4e\x1f\xc8\xef\xef\xae\xc8\x17\xf4\x4b\xc8\x3f\xdc\x5f\x91\xe7\xf9\xff\x94\xff\x2f\x00\x00\xff\xff\x41\xe6\x85\xac\x73\x08\x00\x00")
+var _staticColourlCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\x03\xad\x94\xdf\x6f\xd3\x30\x10\xc7\xdf\xfb\x57\x58\x4c\xd3\x60\x6a\x9a\x74\x6d\x57\x96\x0a\x09\x04\x3c\x20\x98\x78\x98\xe0\x01\x84\x26\x27\xbe\xa4\xde\x1c\x3b\x72\x1c\xd6\x82\xf8\xdf\x39\xe7\xa7\x9b\xa5\x13\x0f\x6c\xd2\xb4\xdc\x9d\xbf\xbe\x1f\x9f\x73\xa4\xd8\x9e\xfc\x9e\x10\xfc\x49\x94\x34\x5e\x42\x33\x2e\xf6\x21\x39\xfb\x9c\x83\x24\x37\x54\x16\x67\x53\x72\xf6\x0e\xee\xe8\xd7\xb2\xfb\xbc\x81\x54\x01\xf9\xf2\x01\xff\x2f\xd0\xe4\x15\xa0\x79\xb2\xa9\x44\x62\x25\x94\x0e\xc9\xc9\x62\xb1\xa8\x0d\x39\x65\x8c\xcb\x34\x24\x01\x99\x6b\xc8\x36\x93\x3f\x93\x09\x6d\x6e\x6c\x83\x83\xf7\xab\x37\x57\xcb\xda\x15\x6e\xd5\x4f\xd0\xc3\x00\x58\xaf\xa3\x3a\x60\x16\x95\xc6\x28\x59\x87\x4d\xc9\xe1\x17\x97\x79\x69\xbe\x9b\x7d\x0e\xaf\x9e\xd5\x9e\x67\x3f\xc6\x7c\x1a\x0a\x30\xe3\xae\xa2\x8c\x32\xde\xf9\x9a\x3c\x22\x1a\xdf\xa7\x5a\x95\x92\x79\x23\x29\xf1\x2c\x6d\xe2\x32\xba\xf3\x1e\x38\x33\xdb\x90\xcc\x83\xe0\xb4\x4e\x38\xc6\xbe\x52\x2e\x3b\xb1\x26\xe0\x6a\x75\xba\x19\x1e\xba\x0c\x82\x7c\xd7\x5a\x75\xca\x65\x48\x68\x69\x54\x6d\x49\x84\xa2\x26\x24\x02\x12\xd3\x08\x83\x34\x9d\xaa\x81\x9d\xf1\xa8\xe0\x29\x9e\xa9\x1d\x55\x50\xae\x61\x8a\x6d\x64\x30\x3a\x64\x67\xae\xe4\x5a\x49\x65\x87\xfb\x89\x47\xa0\xa9\xe1\x4a\x76\xa6\xb7\x4a\x16\x4a\x50\x3b\xfb\x0c\x4d\x45\x4e\x63\x70\x87\xd1\x8e\x61\x7c\x00\xa3\xad\x1f\x6f\xfa\x13\xed\x6e\x10\xa9\xdc\x4a\x33\x40\x63\xe0\x7e\x7a\x9a\x32\x5e\x16\x68\x9d\x2d\x2a\xce\x5c\x7e\x92\xa4\xc5\xb3\xd4\x85\xb5\xe4\x8a\xd7\x2d\xb2\x46\xc6\x8b\x5c\x50\xec\x07\x97\x02\xe7\xe4\x45\x42\xc5\xf7\x9b\xbe\x5d\x05\xff\x05\x38\xd0\xd9\xb2\xd3\xed\xa1\x9e\x2d\x21\xc3\xbf\xab\x86\x6c\xff\x7c\x6b\x4c\x1e\xfa\xbe\x6d\x39\x6e\xd0\x8c\x2b\xdf\x28\x1d\x81\xc4\x54\xec\x11\x1f\x8d\x3e\x64\x1f\xaf\xbf\xed\xce\xfd\x49\xa2\x74\x46\x66\x09\x07\xc1\x3c\x5b\x70\xde\x34\xa0\xcb\x28\x11\x30\xe0\x21\xb0\x8b\x74\x91\xef\xda\xea\x1d\x7a\x56\x35\x3d\x98\xc6\x63\x5d\x41\x23\x10\x2d\x03\x28\x8a\xf5\x6c\x1e\x71\xa3\x79\xba\x35\xc3\xeb\xe6\xb3\x0b\x6d\x6b\x6c\x2f\xf4\xcf\xbb\xea\xeb\x34\xb0\x0e\x6b\xaf\x34\xf0\x29\x10\xc9\x01\x80\x8f\x53\xa9\x06\x3f\x1d\x71\xe0\x59\x88\xcd\x41\x92\x8b\x7a\xc1\xaa\x13\x8d\x7f\x5a\xa5\x4c\x35\xb4\xaf\x48\x97\x8e\x33\x20\x77\x6e\x9d\xb1\xe5\x06\x59\xe6\x8c\xcc\x31\xf9\x93\x38\x8e\x37\x03\xe4\x5c\x5c\x22\xb5\xb3\x2a\x95\x7a\x83\x19\x9a\x46\xb1\x6b\xa8\xc3\x6c\x5f\x67\xc0\x38\x25\xcf\x9d\xc9\x2c\x5f\xe2\x64\x5e\x34\xf9\x1e\x54\xd3\xd8\x9c\x77\xa1\x7e\x38\xac\x01\xb5\xea\x5a\xc6\x21\x39\x02\x4a\xdb\x3c\x8f\x71\x8d\x17\xe0\x1e\x87\x76\x0f\xca\x4c\xf6\x7e\x17\xa6\xcb\x9e\xa5\xa3\x17\xba\xf4\x0c\x1e\xf5\x80\xac\x7a\x01\x57\x7b\x1e\x58\x7b\xcf\xcd\x90\x91\x2a\xc7\x02\x07\x69\xda\xcb\xed\x83\x92\x6a\xce\x6e\x6f\xb5\x7a\x38\xbe\x09\x4f\x15\x77\x57\x16\x86\x27\x7b\xcf\xbe\xb9\xc8\x60\x4f\x62\x7f\x3b\x37\x90\x15\x07\x88\x36\x97\x5a\xc7\xd8\x86\x74\xc5\xda\xb5\x6b\x77\x60\x84\x25\x58\xdb\x5f\xbb\x0e\x3d\x04\x54\x08\x42\x25\x43\x18\xb8\x1c\x85\xa1\x2f\xd8\xf3\x8a\xcc\x69\xf1\xb0\x4a\x8c\x70\xfa\xf4\x84\xfc\xfa\xe2\x88\x7c\xc6\xfe\x87\xfc\xd5\xe5\x11\x79\x91\xfe\xa3\xfc\x5f\x41\xe6\x85\xac\x73\x08\x00\x00")
 
 func staticColourlCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -104,7 +104,7 @@ func staticColourlCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/colourl.css", size: 2163, mode: os.FileMode(436), modTime: time.Unix(1465254063, 0)}
+	info := bindataFileInfo{name: "static/colourl.css", size: 2163, mode: os.FileMode(436), modTime: time.Unix(1503228780, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
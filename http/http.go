@@ -7,18 +7,21 @@ import (
 	"context"
 	"fmt"
 	"html/template"
+	"io/ioutil"
+	"math"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/elazarl/go-bindata-assetfs"
+	"github.com/lucasb-eyer/go-colorful"
 	"github.com/nochso/colourl/cache"
+	"github.com/nochso/colourl/css"
 	"github.com/nochso/colourl/palette"
 )
 
-var scorer = &palette.SumScore{}
-
 var tmpl *template.Template
 
 const svgTimeout = time.Second * 5
@@ -43,9 +46,11 @@ func SVGHandler(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 	painter := NewPainter(v)
+	scorer := NewScorer(v)
 	job := NewPaintJob(v)
+	opts := NewGroupOptions(v)
 	// Look for a cached SVG
-	key := svgKey(req.URL, job)
+	key := svgKey(req.URL, job, opts)
 	svg, err := cache.SVG.Get(key)
 	if err == nil {
 		w.Header().Set("Content-Type", "image/svg+xml")
@@ -55,25 +60,85 @@ func SVGHandler(w http.ResponseWriter, req *http.Request) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), svgTimeout)
 	defer cancel()
-	p, err := palette.New(ctx, url, scorer)
+	b, err := paint(ctx, url, v.Get("scheme"), dictionary(v.Get("names")), scorer, opts, painter, job)
 	if err != nil {
 		http.Error(w, "Unable to create a palette: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 	w.Header().Set("Content-Type", "image/svg+xml")
-	b := p.Paint(painter, job)
 	cache.SVG.Set(key, b)
 	w.Write(b)
 }
 
+// Schemes lists the values of the GET parameter "scheme".
+// "light" and "dark" draw the palette of a single color scheme.
+// "auto" draws both and lets the SVG switch between them.
+// An empty scheme draws a single palette of all colors.
+var Schemes = []string{"", "light", "dark", "auto"}
+
+// Names lists the values of the GET parameter "names".
+// Colors of the SVG are titled with the nearest CSS named color or a descriptive name.
+// An empty value leaves colors untitled.
+var Names = []string{"", "css", "extended"}
+
+// dictionary returns the color names picked by the GET parameter "names".
+func dictionary(names string) css.Dictionary {
+	switch names {
+	case "css":
+		return css.CSSNames()
+	case "extended":
+		return css.ExtendedNames()
+	}
+	return nil
+}
+
+// paint draws a SVG of the palette of a URL for a color scheme.
+// Colors are scored by scorer, grouped using opts and named using dict.
+func paint(ctx context.Context, url, scheme string, dict css.Dictionary, scorer palette.Scorer, opts palette.GroupOptions, painter palette.Painter, job palette.PaintJob) ([]byte, error) {
+	switch scheme {
+	case "light", "dark", "auto":
+		light, dark, err := palette.NewSchemesWith(ctx, url, scorer, opts)
+		if err != nil {
+			return nil, err
+		}
+		light.Name(dict)
+		dark.Name(dict)
+		if scheme == "light" {
+			return light.Paint(painter, job), nil
+		}
+		if scheme == "dark" {
+			return dark.Paint(painter, job), nil
+		}
+		return palette.PaintSchemes(light, dark, painter, job), nil
+	}
+	p, err := palette.NewWith(ctx, url, scorer, opts)
+	if err != nil {
+		return nil, err
+	}
+	p.Name(dict)
+	return p.Paint(painter, job), nil
+}
+
 // svgKey creates a key for caching by combining all parameters of a drawing.
-func svgKey(u *url.URL, job palette.PaintJob) string {
-	return fmt.Sprintf("svg:%s %s %d %d %d",
+func svgKey(u *url.URL, job palette.PaintJob, opts palette.GroupOptions) string {
+	return fmt.Sprintf("svg:%s %s %s %s %s %d %d %d %g %g %g %g %g %s %s %t %g",
 		u.String(),
 		u.Query().Get("style"),
+		u.Query().Get("score"),
+		u.Query().Get("scheme"),
+		u.Query().Get("names"),
 		job.Width,
 		job.Height,
 		job.Max,
+		job.Trim.MinSaturation,
+		job.Trim.MinLightness,
+		job.Trim.MaxLightness,
+		job.Trim.Extremes,
+		job.Trim.MinDistance,
+		u.Query().Get("metric"),
+		u.Query().Get("include"),
+		job.Trim.IncludeTheme,
+		opts.Tolerance,
 	)
 }
 
@@ -83,6 +148,16 @@ type IndexView struct {
 	Job      palette.PaintJob
 	Painters map[string]palette.Painter
 	Style    string
+	Scorers  map[string]palette.Scorer
+	Score    string
+	Schemes  []string
+	Scheme   string
+	Names    []string
+	Naming   string
+	Metrics  map[string]palette.Metric
+	Metric   string
+	Include  string
+	Group    palette.GroupOptions
 }
 
 func NewIndexView(req *http.Request) *IndexView {
@@ -96,6 +171,16 @@ func NewIndexView(req *http.Request) *IndexView {
 		NewPaintJob(req.URL.Query()),
 		palette.Painters,
 		req.URL.Query().Get("style"),
+		palette.Scorers,
+		req.URL.Query().Get("score"),
+		Schemes,
+		req.URL.Query().Get("scheme"),
+		Names,
+		req.URL.Query().Get("names"),
+		palette.Metrics,
+		req.URL.Query().Get("metric"),
+		req.URL.Query().Get("include"),
+		NewGroupOptions(req.URL.Query()),
 	}
 }
 
@@ -124,9 +209,73 @@ func NewPaintJob(v url.Values) palette.PaintJob {
 		Max:    parseInt(v.Get("max"), 5, 1, 64),
 		Width:  parseInt(v.Get("w"), 512, 1, 4096),
 		Height: parseInt(v.Get("h"), 512, 1, 4096),
+		Trim:   NewTrimOptions(v),
+	}
+}
+
+// NewGroupOptions creates GroupOptions based on GET parameters.
+// "tolerance" clusters similar colors, see palette.Palette.Cluster.
+func NewGroupOptions(v url.Values) palette.GroupOptions {
+	return palette.GroupOptions{
+		Tolerance: parseFloat(v.Get("tolerance"), 0, 0, 1),
 	}
 }
 
+// NewTrimOptions creates TrimOptions based on GET parameters.
+// "saturation", "lmin", "lmax", "extremes" and "distance" override palette.DefaultTrimOptions,
+// "metric" picks one of palette.Metrics and "include" is a comma separated list of hex colors.
+// Any value of "theme" includes the theme color declared by the website.
+func NewTrimOptions(v url.Values) *palette.TrimOptions {
+	def := palette.DefaultTrimOptions
+	opts := &palette.TrimOptions{
+		MinSaturation: parseFloat(v.Get("saturation"), def.MinSaturation, 0, 1),
+		MinLightness:  parseFloat(v.Get("lmin"), def.MinLightness, 0, 1),
+		MaxLightness:  parseFloat(v.Get("lmax"), def.MaxLightness, 0, 1),
+		Extremes:      parseFloat(v.Get("extremes"), def.Extremes, 0, 1),
+		MinDistance:   parseFloat(v.Get("distance"), def.MinDistance, 0, 1),
+		Metric:        palette.Metrics[v.Get("metric")],
+		IncludeTheme:  v.Get("theme") != "",
+	}
+	for _, hex := range strings.Split(v.Get("include"), ",") {
+		c, err := colorful.Hex(strings.ToLower(strings.TrimSpace(hex)))
+		if err == nil {
+			opts.Include = append(opts.Include, c)
+		}
+	}
+	return opts
+}
+
+// LoadProfiles reads scoring profiles from a JSON or YAML file and offers them by
+// name for the GET parameter "score". See palette.ParseProfiles.
+func LoadProfiles(path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	profiles, err := palette.ParseProfiles(b)
+	if err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+	for _, p := range profiles {
+		s, err := p.Build()
+		if err != nil {
+			return fmt.Errorf("%s: profile %q: %s", path, p.Name, err)
+		}
+		palette.Scorers[p.Name] = s
+	}
+	return nil
+}
+
+// NewScorer picks a Scorer based on the GET parameter "score".
+// Colors are scored by frequency by default.
+func NewScorer(v url.Values) (s palette.Scorer) {
+	s, ok := palette.Scorers[v.Get("score")]
+	if !ok {
+		s = &palette.SumScore{}
+	}
+	return
+}
+
 // NewPainter picks a Painter based on the GET parameter "style".
 func NewPainter(v url.Values) (p palette.Painter) {
 	p, ok := palette.Painters[v.Get("style")]
@@ -136,6 +285,14 @@ func NewPainter(v url.Values) (p palette.Painter) {
 	return
 }
 
+func parseFloat(s string, def, min, max float64) float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) {
+		return def
+	}
+	return math.Max(min, math.Min(max, f))
+}
+
 func parseInt(s string, def, min, max int) int {
 	i, err := strconv.Atoi(s)
 	if err != nil {
@@ -0,0 +1,61 @@
+package page
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// iconRels are the "rel" tokens of <link> elements referencing icons.
+// `mask-icon` is not included as it refers to a single colored SVG.
+var iconRels = map[string]bool{
+	"icon":                         true,
+	"apple-touch-icon":             true,
+	"apple-touch-icon-precomposed": true,
+}
+
+// addIcons downloads all linked icons like favicons and touch icons.
+func (p *Page) addIcons(ctx context.Context) {
+	for _, u := range p.iconURLs() {
+		if p.Count() >= MaxFileCount {
+			return
+		}
+		icon, err := p.NewFile(ctx, u.String())
+		if err != nil { // Log and continue on error
+			log.Warnf("could not get icon mentioned in '%s': %s", p.HTML.URL, err)
+			continue
+		}
+		p.Icons = append(p.Icons, icon)
+	}
+}
+
+// iconURLs extracts URLs to icons embedded in a Page's HTML body.
+// If there are none, `/favicon.ico` is returned like browsers would request it.
+func (p *Page) iconURLs() []*url.URL {
+	urls := p.linkURLs(func(rel string) bool {
+		for _, r := range strings.Fields(strings.ToLower(rel)) {
+			if iconRels[r] {
+				return true
+			}
+		}
+		return false
+	})
+	if len(urls) == 0 {
+		u, err := p.HTML.URL.Parse("/favicon.ico")
+		if err == nil {
+			urls = append(urls, u)
+		}
+	}
+	// Remove duplicates
+	seen := map[string]bool{}
+	unique := urls[:0]
+	for _, u := range urls {
+		if !seen[u.String()] {
+			seen[u.String()] = true
+			unique = append(unique, u)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,35 @@
+package page
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewIcons(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	p, err := New(context.Background(), s.URL+"/icon.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Icons) != 1 || p.Icons[0].URL.Path != "/icon.png" {
+		t.Fatalf("Expecting a single icon /icon.png, got %d icons", len(p.Icons))
+	}
+}
+
+func TestNewFavicon(t *testing.T) {
+	s := httptest.NewServer(http.FileServer(http.Dir("test/favicon")))
+	defer s.Close()
+	p, err := New(context.Background(), s.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Icons) != 1 || p.Icons[0].URL.Path != "/favicon.ico" {
+		t.Fatalf("Expecting /favicon.ico as fallback, got %d icons", len(p.Icons))
+	}
+	if p.Count() != 2 {
+		t.Fatalf("Count must be 2: HTML & icon, got %d", p.Count())
+	}
+}
@@ -0,0 +1,48 @@
+package page
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// AddImages downloads images like those referenced by background properties.
+// URLs already downloaded are skipped. Downloads stop once MaxImageCount is reached.
+func (p *Page) AddImages(ctx context.Context, urls []*url.URL) {
+	seen := map[string]bool{}
+	for _, img := range p.Images {
+		seen[img.URL.String()] = true
+	}
+	for _, u := range urls {
+		if len(p.Images) >= MaxImageCount {
+			return
+		}
+		if seen[u.String()] {
+			continue
+		}
+		seen[u.String()] = true
+		img, err := p.get(ctx, u.String(), p.checkImageSize)
+		if err != nil { // Log and continue on error
+			log.Warnf("could not get image mentioned in '%s': %s", p.HTML.URL, err)
+			continue
+		}
+		p.Images = append(p.Images, img)
+	}
+}
+
+// checkImageSize returns an error if an image would exceed the limits of images.
+func (p *Page) checkImageSize(length int64) error {
+	if length > MaxFileSize {
+		return fmt.Errorf("Response body with length %d exceeds MaxFileSize %d", length, MaxFileSize)
+	}
+	var size int64
+	for _, img := range p.Images {
+		size += int64(len(img.Body))
+	}
+	if size+length > MaxImageSize {
+		return fmt.Errorf("Response body with length %d exceeds MaxImageSize %d of images with current size %d", length, MaxImageSize, size)
+	}
+	return nil
+}
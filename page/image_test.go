@@ -0,0 +1,73 @@
+package page
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+// imageURLs returns URLs of images served by the test server.
+func imageURLs(t *testing.T, base string, paths ...string) []*url.URL {
+	var urls []*url.URL
+	for _, p := range paths {
+		u, err := url.Parse(base + p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		urls = append(urls, u)
+	}
+	return urls
+}
+
+func TestPage_AddImages(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	p, err := New(context.Background(), s.URL+"/embedded.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := p.Count()
+	p.AddImages(context.Background(), imageURLs(t, s.URL, "/icon.png", "/missing.png", "/img/hero.png", "/icon.png"))
+	exp := []string{"/icon.png", "/img/hero.png"}
+	if len(p.Images) != len(exp) {
+		t.Fatalf("Expecting %d images, got %d", len(exp), len(p.Images))
+	}
+	for i, e := range exp {
+		if p.Images[i].URL.Path != e {
+			t.Errorf("Expecting image #%d to be %s, got %s", i, e, p.Images[i].URL.Path)
+		}
+	}
+	if p.Count() != count {
+		t.Fatalf("Images must not be counted: expecting %d files, got %d", count, p.Count())
+	}
+}
+
+func TestPage_AddImagesMaxImageCount(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	defer func() { MaxImageCount = DefaultMaxImageCount }()
+	MaxImageCount = 1
+	p, err := New(context.Background(), s.URL+"/embedded.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.AddImages(context.Background(), imageURLs(t, s.URL, "/icon.png", "/img/hero.png"))
+	if len(p.Images) != 1 {
+		t.Fatalf("Expecting MaxImageCount of 1 image, got %d", len(p.Images))
+	}
+}
+
+func TestPage_AddImagesMaxImageSize(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	defer func() { MaxImageSize = DefaultMaxImageSize }()
+	MaxImageSize = 150
+	p, err := New(context.Background(), s.URL+"/embedded.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.AddImages(context.Background(), imageURLs(t, s.URL, "/icon.png", "/img/hero.png"))
+	if len(p.Images) != 1 {
+		t.Fatalf("Expecting MaxImageSize to allow 1 image, got %d", len(p.Images))
+	}
+}
@@ -0,0 +1,51 @@
+package page
+
+import (
+	"strings"
+
+	"github.com/tdewolff/parse/css"
+)
+
+// importURLs extracts the URLs of all @import rules in a stylesheet.
+// The URLs are returned as written and still need to be resolved.
+func importURLs(sheet string) []string {
+	p := css.NewParser(strings.NewReader(sheet), false)
+	var urls []string
+	for {
+		gt, tt, data := p.Next()
+		if gt == css.ErrorGrammar {
+			// ignore *asterisks froms browser/version specific rules
+			if tt == css.DelimToken && string(data) == "*" {
+				continue
+			}
+			break
+		}
+		if gt != css.AtRuleGrammar || !strings.EqualFold(string(data), "@import") {
+			continue
+		}
+		for _, t := range p.Values() {
+			if t.TokenType == css.WhitespaceToken {
+				continue
+			}
+			if link := TokenURL(t); link != "" {
+				urls = append(urls, link)
+			}
+			break
+		}
+	}
+	return urls
+}
+
+// TokenURL returns the URL of a token like the first token of an @import rule,
+// i.e. `url(a.css)`, `url("a.css")` or `"a.css"`.
+func TokenURL(t css.Token) string {
+	s := string(t.Data)
+	switch t.TokenType {
+	case css.URLToken:
+		s = strings.TrimSpace(s[strings.Index(s, "(")+1 : len(s)-1])
+	case css.StringToken:
+	default:
+		return ""
+	}
+	return strings.Trim(s, `"'`)
+}
@@ -0,0 +1,23 @@
+package page
+
+import (
+	"testing"
+)
+
+func TestImportURLs(t *testing.T) {
+	sheet := `@import url(a.css);
+@import url( "b.css" ) screen;
+@IMPORT 'c.css';
+@media print { a { color: red } }
+@import d.css;`
+	exp := []string{"a.css", "b.css", "c.css"}
+	urls := importURLs(sheet)
+	if len(urls) != len(exp) {
+		t.Fatalf("Expecting %d URLs, got %d: %v", len(exp), len(urls), urls)
+	}
+	for i, u := range urls {
+		if u != exp[i] {
+			t.Errorf("Expecting URL %s, got %s", exp[i], u)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package page
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/html"
+)
+
+// addLogos downloads SVG images that look like logos.
+func (p *Page) addLogos(ctx context.Context) {
+	for _, u := range p.logoURLs() {
+		if p.Count() >= MaxFileCount {
+			return
+		}
+		logo, err := p.NewFile(ctx, u.String())
+		if err != nil { // Log and continue on error
+			log.Warnf("could not get logo mentioned in '%s': %s", p.HTML.URL, err)
+			continue
+		}
+		p.Logos = append(p.Logos, logo)
+	}
+}
+
+// logoURLs extracts URLs of same-origin SVG images that look like logos.
+// An <img> is considered a logo if it is inside a <header> or <nav> element,
+// or if its "alt" or "class" attribute contains "logo".
+func (p *Page) logoURLs() []*url.URL {
+	tokenizer := html.NewTokenizer(strings.NewReader(p.HTML.Body))
+	var urls []*url.URL
+	seen := map[string]bool{}
+	// Depth of open <header> and <nav> elements
+	depth := 0
+	for {
+		tt := tokenizer.Next()
+		switch tt {
+		case html.ErrorToken: // End of document
+			return urls
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			if (string(name) == "header" || string(name) == "nav") && depth > 0 {
+				depth--
+			}
+			continue
+		case html.StartTagToken, html.SelfClosingTagToken:
+		default:
+			continue
+		}
+		t := tokenizer.Token()
+		switch t.Data {
+		case "header", "nav":
+			if tt == html.StartTagToken {
+				depth++
+			}
+			continue
+		case "img":
+		default:
+			continue
+		}
+		var src string
+		isLogo := depth > 0
+		for _, attr := range t.Attr {
+			switch attr.Key {
+			case "src":
+				src = attr.Val
+			case "alt", "class":
+				if strings.Contains(strings.ToLower(attr.Val), "logo") {
+					isLogo = true
+				}
+			}
+		}
+		if !isLogo || src == "" {
+			continue
+		}
+		u, err := p.HTML.URL.Parse(src)
+		if err != nil {
+			log.Warnf("could not parse logo '%s': %s", src, err)
+			continue
+		}
+		if !p.isSameOrigin(u) || !strings.EqualFold(path.Ext(u.Path), ".svg") || seen[u.String()] {
+			continue
+		}
+		seen[u.String()] = true
+		urls = append(urls, u)
+	}
+}
+
+// isSameOrigin reports whether u has the same scheme and host as the Page's HTML.
+func (p *Page) isSameOrigin(u *url.URL) bool {
+	return u.Scheme == p.HTML.URL.Scheme && u.Host == p.HTML.URL.Host
+}
@@ -1,4 +1,4 @@
-// Package page helps fetching a HTML page and its referenced CSS files.
+// Package page helps fetching a HTML page and its referenced CSS files, manifest and images.
 package page
 
 import (
@@ -17,53 +17,79 @@ import (
 
 // Default limits for fetching a Page.
 var (
-	DefaultMaxPageSize  int64 = 1024 * 1024 * 10
-	DefaultMaxFileCount int   = 15
-	DefaultMaxFileSize  int64 = 1024 * 1024 * 5
+	DefaultMaxPageSize   int64 = 1024 * 1024 * 10
+	DefaultMaxFileCount  int   = 15
+	DefaultMaxFileSize   int64 = 1024 * 1024 * 5
+	DefaultMaxImageSize  int64 = 1024 * 1024 * 10
+	DefaultMaxImageCount int   = 10
 )
 
 // Limits for fetching a Page.
+// Images referenced by CSS have their own limits and do not count towards
+// MaxPageSize and MaxFileCount. Each image is still limited by MaxFileSize.
 var (
-	MaxPageSize  = DefaultMaxPageSize
-	MaxFileCount = DefaultMaxFileCount
-	MaxFileSize  = DefaultMaxFileSize
+	MaxPageSize   = DefaultMaxPageSize
+	MaxFileCount  = DefaultMaxFileCount
+	MaxFileSize   = DefaultMaxFileSize
+	MaxImageSize  = DefaultMaxImageSize
+	MaxImageCount = DefaultMaxImageCount
 )
 
 // Page contains HTML and linked CSS files for a specific URL.
 type Page struct {
 	HTML *File
 	CSS  []*File
+	// Manifest is the linked Web App Manifest. It is nil if there is none.
+	Manifest *File
+	// Logos are same-origin SVG images that look like logos.
+	Logos []*File
+	// Icons are raster images like favicons and touch icons.
+	Icons []*File
+	// Images are referenced by url() in background properties of CSS.
+	Images []*File
 }
 
 // File consists of the content and URL of a single file.
 type File struct {
 	Body string
 	URL  *url.URL
+	// ImportedBy is the file containing the @import rule of this file.
+	// It is nil for files that were not imported.
+	ImportedBy *File
 }
 
-// Count returns the amount of files.
+// Count returns the amount of files. Images are not included.
 func (p *Page) Count() int {
-	c := len(p.CSS)
-	if p.HTML != nil {
-		c++
-	}
-	return c
+	return len(p.files())
 }
 
-// Size returns the length of files.
+// Size returns the length of files. Images are not included.
 func (p *Page) Size() int64 {
 	var s int64
+	for _, f := range p.files() {
+		s += int64(len(f.Body))
+	}
+	return s
+}
+
+// files returns all files of a Page.
+func (p *Page) files() []*File {
+	var files []*File
 	if p.HTML != nil {
-		s += int64(len(p.HTML.Body))
+		files = append(files, p.HTML)
 	}
-	for _, c := range p.CSS {
-		s += int64(len(c.Body))
+	files = append(files, p.CSS...)
+	if p.Manifest != nil {
+		files = append(files, p.Manifest)
 	}
-	return s
+	files = append(files, p.Logos...)
+	return append(files, p.Icons...)
 }
 
 // New Page from a URL.
-// Any linked CSS stylesheets will be downloaded.
+// Any linked CSS stylesheets, the Web App Manifest, SVG logos and icons will be downloaded.
+// Stylesheets referenced by @import rules are downloaded recursively.
+// Background images are downloaded separately by Page.AddImages.
 func New(ctx context.Context, u string) (*Page, error) {
 	p := &Page{}
 	html, err := p.NewFile(ctx, u) // Get HTML body
@@ -71,22 +97,81 @@ func New(ctx context.Context, u string) (*Page, error) {
 		return nil, err
 	}
 	p.HTML = html
+	seen := map[string]bool{html.URL.String(): true}
+	// Imports within <style> elements
+	for _, style := range p.styleElements() {
+		p.importCSS(ctx, style, html, seen)
+	}
 	for _, c := range p.cssURLs() { // Iterate over links to CSS files
-		if p.Count() >= MaxFileCount {
-			break
+		p.addCSS(ctx, c, nil, seen)
+	}
+	p.addManifest(ctx)
+	p.addLogos(ctx)
+	p.addIcons(ctx)
+	return p, nil
+}
+
+// addManifest downloads the first linked Web App Manifest.
+func (p *Page) addManifest(ctx context.Context) {
+	urls := p.manifestURLs()
+	if len(urls) == 0 || p.Count() >= MaxFileCount {
+		return
+	}
+	m, err := p.NewFile(ctx, urls[0].String())
+	if err != nil { // Log and continue on error
+		log.Warnf("could not get manifest mentioned in '%s': %s", p.HTML.URL, err)
+		return
+	}
+	p.Manifest = m
+}
+
+// addCSS downloads a stylesheet and any stylesheets it imports.
+// Imported stylesheets are added before the stylesheet importing them.
+// URLs already in seen are skipped to avoid duplicates and import cycles.
+func (p *Page) addCSS(ctx context.Context, u *url.URL, importedBy *File, seen map[string]bool) {
+	if p.Count() >= MaxFileCount || seen[u.String()] {
+		return
+	}
+	seen[u.String()] = true
+	css, err := p.NewFile(ctx, u.String())
+	if err != nil { // Log and continue on error
+		mentionedIn := p.HTML.URL
+		if importedBy != nil {
+			mentionedIn = importedBy.URL
 		}
-		css, err := p.NewFile(ctx, c.String())
-		if err != nil { // Log and continue on error
-			log.Warnf("could not get CSS mentioned in '%s': %s", p.HTML.URL, err)
-		} else {
-			p.CSS = append(p.CSS, css)
+		log.Warnf("could not get CSS mentioned in '%s': %s", mentionedIn, err)
+		return
+	}
+	css.ImportedBy = importedBy
+	// Add it right away so imports are counted towards the limits
+	p.CSS = append(p.CSS, css)
+	i := len(p.CSS) - 1
+	p.importCSS(ctx, css.Body, css, seen)
+	// Move it behind its imports
+	p.CSS = append(append(p.CSS[:i], p.CSS[i+1:]...), css)
+}
+
+// importCSS downloads all stylesheets imported by a stylesheet.
+// URLs are resolved relative to the file containing the stylesheet.
+func (p *Page) importCSS(ctx context.Context, sheet string, f *File, seen map[string]bool) {
+	for _, link := range importURLs(sheet) {
+		u, err := f.URL.Parse(link)
+		if err != nil {
+			log.Warnf("could not parse CSS import '%s' in '%s': %s", link, f.URL, err)
+			continue
 		}
+		p.addCSS(ctx, u, f, seen)
 	}
-	return p, nil
 }
 
 // NewFile creates a new File by GETting it from url.
 func (p *Page) NewFile(ctx context.Context, url string) (*File, error) {
+	return p.get(ctx, url, p.checkSize)
+}
+
+// get creates a new File by GETting it from url.
+// The size of the response body is checked by the function check.
+func (p *Page) get(ctx context.Context, url string, check func(length int64) error) (*File, error) {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
@@ -111,7 +196,7 @@ func (p *Page) NewFile(ctx context.Context, url string) (*File, error) {
 	// Abort early if reported size would exceed limits
 	cl, err := strconv.ParseInt(r.Header.Get("Content-Length"), 10, 0)
 	if err == nil {
-		err = p.checkSize(cl)
+		err = check(cl)
 		if err != nil {
 			return nil, err
 		}
@@ -127,7 +212,7 @@ func (p *Page) NewFile(ctx context.Context, url string) (*File, error) {
 	f.Body = string(b)
 
 	// Abort if actual size exceeds limits
-	err = p.checkSize(int64(len(f.Body)))
+	err = check(int64(len(f.Body)))
 	if err != nil {
 		return nil, err
 	}
@@ -148,8 +233,41 @@ func (p *Page) checkSize(length int64) error {
 	return nil
 }
 
+// styleElements returns the content of all <style> elements in a Page's HTML body.
+func (p *Page) styleElements() []string {
+	tokenizer := html.NewTokenizer(strings.NewReader(p.HTML.Body))
+	var styles []string
+	inStyle := false
+	for {
+		tt := tokenizer.Next()
+		switch tt {
+		case html.ErrorToken: // End of document
+			return styles
+		case html.StartTagToken:
+			name, _ := tokenizer.TagName()
+			inStyle = string(name) == "style"
+		case html.TextToken:
+			if inStyle {
+				styles = append(styles, string(tokenizer.Text()))
+			}
+		default:
+			inStyle = false
+		}
+	}
+}
+
 // cssURLs extracts URLs to CSS files embedded in a Page's HTML body.
 func (p *Page) cssURLs() []*url.URL {
+	return p.linkURLs(func(rel string) bool { return rel == "stylesheet" })
+}
+
+// manifestURLs extracts URLs to Web App Manifests embedded in a Page's HTML body.
+func (p *Page) manifestURLs() []*url.URL {
+	return p.linkURLs(func(rel string) bool { return strings.ToLower(rel) == "manifest" })
+}
+
+// linkURLs extracts URLs of <link> elements whose "rel" attribute matches.
+func (p *Page) linkURLs(match func(rel string) bool) []*url.URL {
 	tokenizer := html.NewTokenizer(strings.NewReader(p.HTML.Body))
 	urls := make([]*url.URL, 0)
 	var tt html.TokenType
@@ -167,21 +285,21 @@ func (p *Page) cssURLs() []*url.URL {
 		if t.Data != "link" {
 			continue
 		}
-		isStyleSheet := false
+		isMatch := false
 		var link string
 		for _, attr := range t.Attr {
-			if attr.Key == "rel" && attr.Val == "stylesheet" {
-				isStyleSheet = true
+			if attr.Key == "rel" && match(attr.Val) {
+				isMatch = true
 			} else if attr.Key == "href" {
 				link = attr.Val
 			}
 		}
 
-		// If it links to a stylesheet, resolve the URL based on the URL referencing it
-		if isStyleSheet && link != "" {
+		// If the link matches, resolve the URL based on the URL referencing it
+		if isMatch && link != "" {
 			u, err := p.HTML.URL.Parse(link)
 			if err != nil {
-				log.Warnf("could not parse CSS link '%s': %s", link, err)
+				log.Warnf("could not parse link '%s': %s", link, err)
 				continue
 			}
 			urls = append(urls, u)
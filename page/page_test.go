@@ -94,3 +94,96 @@ func TestNewInvalidHost(t *testing.T) {
 		t.Fatal("Must return error for unknown domain")
 	}
 }
+
+// Test fetching stylesheets from @import rules in style elements and CSS files.
+func TestNewImport(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	p, err := New(context.Background(), s.URL+"/import.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exp = []struct {
+		path       string
+		importedBy string
+	}{
+		{"/style.css", "/nested.css"},
+		{"/nested.css", "/import.css"},
+		{"/import.css", "/import.html"},
+	}
+	if len(p.CSS) != len(exp) {
+		t.Fatalf("Expecting %d CSS files, got %d", len(exp), len(p.CSS))
+	}
+	for i, e := range exp {
+		if p.CSS[i].URL.Path != e.path {
+			t.Errorf("Expecting CSS file #%d to be %s, got %s", i, e.path, p.CSS[i].URL.Path)
+		}
+		if p.CSS[i].ImportedBy == nil || p.CSS[i].ImportedBy.URL.Path != e.importedBy {
+			t.Errorf("Expecting %s to be imported by %s", e.path, e.importedBy)
+		}
+	}
+}
+
+func TestNewImportMaxFileCount(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	defer func() { MaxFileCount = DefaultMaxFileCount }()
+	MaxFileCount = 2
+	p, err := New(context.Background(), s.URL+"/import.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Count() != 2 {
+		t.Fatalf("Expecting MaxFileCount of 2 files, got %d", p.Count())
+	}
+}
+
+func TestNewManifest(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	p, err := New(context.Background(), s.URL+"/manifest.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Manifest == nil || p.Manifest.URL.Path != "/site.webmanifest" {
+		t.Fatal("Expecting manifest /site.webmanifest")
+	}
+	if p.Count() != 3 {
+		t.Fatalf("Count must be 3: HTML, CSS & manifest, got %d", p.Count())
+	}
+}
+
+func TestNewManifestMaxFileCount(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	defer func() { MaxFileCount = DefaultMaxFileCount }()
+	MaxFileCount = 2
+	p, err := New(context.Background(), s.URL+"/manifest.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Manifest != nil {
+		t.Fatal("Manifest must not be fetched when exceeding MaxFileCount")
+	}
+}
+
+func TestNewLogos(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	p, err := New(context.Background(), s.URL+"/logo.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []string{"/logo.svg", "/footer.svg"}
+	if len(p.Logos) != len(exp) {
+		t.Fatalf("Expecting %d logos, got %d", len(exp), len(p.Logos))
+	}
+	for i, e := range exp {
+		if p.Logos[i].URL.Path != e {
+			t.Errorf("Expecting logo #%d to be %s, got %s", i, e, p.Logos[i].URL.Path)
+		}
+	}
+	if p.Count() != 3 {
+		t.Fatalf("Count must be 3: HTML & 2 logos, got %d", p.Count())
+	}
+}
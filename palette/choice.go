@@ -0,0 +1,189 @@
+package palette
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/nochso/colourl/css"
+	"github.com/nochso/colourl/page"
+)
+
+// Choice is a color chosen for a purpose.
+type Choice struct {
+	Color *colorful.Color
+	// Confidence from 0 (a guess) to 1 (certain) that Color serves the purpose.
+	Confidence float64
+}
+
+// Choices of a website for decorating links.
+// The Color of a choice is nil if nothing could be chosen.
+type Choices struct {
+	Dominant   Choice
+	Accent     Choice
+	Background Choice
+	Text       Choice
+}
+
+// pageSelectors are subjects of rules styling the whole page.
+var pageSelectors = map[string]bool{
+	"html":  true,
+	"body":  true,
+	":root": true,
+}
+
+// pageWeight multiplies the score of backgrounds and text colors of the whole page.
+const pageWeight = 4
+
+// minAccentSaturation is the saturation above which an accent is certainly colorful.
+const minAccentSaturation = 0.5
+
+// minTextContrast is the WCAG contrast ratio above which text is certainly readable.
+const minTextContrast = 4.5
+
+// NewChoices picks the dominant, accent, background and text colors of a website.
+func NewChoices(ctx context.Context, url string, scorer Scorer) (Choices, error) {
+	pg, err := page.New(ctx, url)
+	if err != nil {
+		return Choices{}, err
+	}
+	cml, err := css.ParsePage(pg)
+	if err != nil {
+		return Choices{}, err
+	}
+	return Choose(cml, scorer), nil
+}
+
+// Choose picks the dominant, accent, background and text colors of a CML.
+// If scorer is nil, it will fall back on palette.SumScore
+func Choose(cml *css.CML, scorer Scorer) Choices {
+	pal := Group(cml, scorer)
+	bg := Background(cml, scorer)
+	return Choices{
+		Dominant:   pal.Dominant(),
+		Accent:     pal.Accent(),
+		Background: bg,
+		Text:       text(cml, scorer, bg),
+	}
+}
+
+// Dominant returns the color with the highest score.
+// The confidence is its share of the sum of all scores.
+func (p Palette) Dominant() Choice {
+	sum := p.ScoreSum()
+	if len(p) == 0 || sum <= 0 {
+		return Choice{}
+	}
+	return Choice{p[0].Color, p[0].Score / sum}
+}
+
+// Accent returns the most distinctive color, i.e. the colorful color with the highest
+// score weighted by its saturation. Gray, almost white and almost black colors are ignored.
+// The confidence is its share of the weighted scores, lowered for dull colors.
+func (p Palette) Accent() Choice {
+	var best *ColorScore
+	bestWeight, sum := 0.0, 0.0
+	for _, c := range p {
+		if DefaultTrimOptions.Boring(c.Color) {
+			continue
+		}
+		_, s, _ := c.Color.Hsv()
+		w := c.Score * s
+		sum += w
+		if w > bestWeight {
+			best, bestWeight = c, w
+		}
+	}
+	if best == nil {
+		return Choice{}
+	}
+	_, s, _ := best.Color.Hsv()
+	return Choice{best.Color, bestWeight / sum * math.Min(1, s/minAccentSaturation)}
+}
+
+// Background returns the likely background color of a page.
+// Background colors of the whole page, e.g. of `body`, count more than those of other elements.
+// The confidence is the share of the chosen color among all background colors.
+func Background(cml *css.CML, scorer Scorer) Choice {
+	return GroupWith(cml, &pageScore{Role: RoleBackground, Scorer: scorer}, GroupOptions{MinAlpha: 0.5}).Dominant()
+}
+
+// Text returns the likely color of body text.
+// Text colors of the whole page, e.g. of `body`, count more than those of other elements.
+// The confidence is the share of the chosen color among all text colors, lowered if
+// it does not contrast well with the chosen Background.
+func Text(cml *css.CML, scorer Scorer) Choice {
+	return text(cml, scorer, Background(cml, scorer))
+}
+
+// text returns the likely color of body text on the chosen background bg.
+func text(cml *css.CML, scorer Scorer, bg Choice) Choice {
+	c := GroupWith(cml, &pageScore{Role: RoleText, Scorer: scorer}, GroupOptions{MinAlpha: 0.5}).Dominant()
+	if c.Color != nil && bg.Color != nil {
+		c.Confidence *= math.Min(1, contrast(*c.Color, *bg.Color)/minTextContrast)
+	}
+	return c
+}
+
+// pageScore only scores mentions of a single Role and weighs rules of the whole page higher.
+type pageScore struct {
+	Role   Role
+	Scorer Scorer
+}
+
+// Score implements palette.Scorer
+func (sc *pageScore) Score(cml *css.CML, cm *css.ColorMention) float64 {
+	if RoleOf(cm) != sc.Role {
+		return 0
+	}
+	score := (&SumScore{}).Score(cml, cm)
+	if sc.Scorer != nil {
+		score = sc.Scorer.Score(cml, cm)
+	}
+	if isPage(cm) {
+		return score * pageWeight
+	}
+	return score
+}
+
+var _ Scorer = (*pageScore)(nil)
+
+// isPage reports whether a mention applies to the whole page.
+func isPage(cm *css.ColorMention) bool {
+	list, err := css.ParseSelectors(cm.Selector)
+	if err != nil || len(list) == 0 {
+		return false
+	}
+	for _, sel := range list {
+		if len(sel) == 0 {
+			return false
+		}
+		subject := sel[len(sel)-1]
+		name := strings.ToLower(subject.Tag)
+		for _, p := range subject.Pseudos {
+			if p.Name == "root" {
+				name = ":root"
+			}
+		}
+		if !pageSelectors[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// contrast returns the WCAG contrast ratio of two colors from 1 to 21.
+func contrast(a, b colorful.Color) float64 {
+	la, lb := luminance(a), luminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// luminance returns the relative luminance of a color as defined by WCAG.
+func luminance(c colorful.Color) float64 {
+	r, g, b := c.Clamped().LinearRgb()
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
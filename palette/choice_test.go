@@ -0,0 +1,56 @@
+package palette
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nochso/colourl/css"
+)
+
+func TestChoose(t *testing.T) {
+	cml := &css.CML{Mentions: css.ParseStylesheet(`body { background: #fafafa; color: #333 }
+.card, .panel { background: #fff }
+a, a:hover { color: #0066cc }
+.btn { background: #e63946 }
+.note { color: #999 }`)}
+	choices := Choose(cml, nil)
+	var tests = []struct {
+		name       string
+		choice     Choice
+		hex        string
+		confidence float64
+	}{
+		{"dominant", choices.Dominant, "#ffffff", 2.0 / 8},
+		{"accent", choices.Accent, "#0066cc", 2 / (2 + (230.0-57)/230)},
+		{"background", choices.Background, "#fafafa", 4.0 / 7},
+		{"text", choices.Text, "#333333", 4.0 / 7},
+	}
+	for _, tt := range tests {
+		if tt.choice.Color == nil {
+			t.Errorf("Expecting %s %s, got nothing", tt.name, tt.hex)
+			continue
+		}
+		if tt.choice.Color.Hex() != tt.hex {
+			t.Errorf("Expecting %s %s, got %s", tt.name, tt.hex, tt.choice.Color.Hex())
+		}
+		if math.Abs(tt.choice.Confidence-tt.confidence) > 1e-9 {
+			t.Errorf("Expecting %s confidence %g, got %g", tt.name, tt.confidence, tt.choice.Confidence)
+		}
+	}
+}
+
+func TestChoose_Uncertain(t *testing.T) {
+	cml := &css.CML{Mentions: css.ParseStylesheet(`body { background: #fff; color: #eee }
+p { color: #ddd; border-color: #b09090 }`)}
+	choices := Choose(cml, nil)
+	if choices.Text.Color == nil || choices.Text.Confidence >= 0.5 {
+		t.Errorf("Expecting low confidence for text with poor contrast, got %+v", choices.Text)
+	}
+	if c := choices.Accent.Confidence; c <= 0 || c >= 0.5 {
+		t.Errorf("Expecting low confidence for a dull accent, got %g", c)
+	}
+	if choices := Choose(&css.CML{}, nil); choices.Dominant.Color != nil || choices.Accent.Color != nil ||
+		choices.Background.Color != nil || choices.Text.Color != nil {
+		t.Errorf("Expecting no choices without mentions, got %+v", choices)
+	}
+}
@@ -0,0 +1,34 @@
+package palette
+
+import "sort"
+
+// Cluster merges perceptually similar colors of a Palette.
+// Colors are visited by descending score and join the first cluster whose representative
+// is within tolerance, otherwise they start a new cluster. The tolerance is a CIEDE2000
+// distance as returned by go-colorful, where about 0.01 is a just noticeable difference.
+//
+// The representative of a cluster is its highest scoring color and its score is the sum
+// of all Members. Clusters are sorted by score.
+func (p Palette) Cluster(tolerance float64) Palette {
+	sorted := append(Palette(nil), p...)
+	sort.Sort(sorted)
+	clusters := Palette{}
+	for _, c := range sorted {
+		var cluster *ColorScore
+		for _, cl := range clusters {
+			if c.Color.DistanceCIEDE2000(*cl.Color) <= tolerance {
+				cluster = cl
+				break
+			}
+		}
+		if cluster == nil {
+			cluster = &ColorScore{Color: c.Color, Name: c.Name}
+			clusters = append(clusters, cluster)
+		}
+		cluster.Score += c.Score
+		cluster.Members = append(cluster.Members, c)
+		cluster.Theme = cluster.Theme || c.Theme
+	}
+	sort.Sort(clusters)
+	return clusters
+}
@@ -0,0 +1,46 @@
+package palette
+
+import (
+	"testing"
+
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/nochso/colourl/css"
+)
+
+func TestPalette_Cluster(t *testing.T) {
+	var mentions []*css.ColorMention
+	for hex, count := range map[string]int{"#333333": 1, "#343434": 2, "#323232": 1, "#ff0000": 4, "#fe0101": 1, "#0000ff": 1} {
+		c, _ := colorful.Hex(hex)
+		for i := 0; i < count; i++ {
+			mentions = append(mentions, &css.ColorMention{Color: &c, Alpha: 1})
+		}
+	}
+	cml := &css.CML{Mentions: mentions}
+	p := GroupWith(cml, nil, GroupOptions{Tolerance: 0.02})
+	var exp = []struct {
+		hex     string
+		score   float64
+		members int
+	}{
+		{"#ff0000", 5, 2},
+		{"#343434", 4, 3},
+		{"#0000ff", 1, 1},
+	}
+	if len(p) != len(exp) {
+		t.Fatalf("Expecting %d clusters, got %d:\n%s", len(exp), len(p), p)
+	}
+	for i, c := range p {
+		if c.Color.Hex() != exp[i].hex || c.Score != exp[i].score || len(c.Members) != exp[i].members {
+			t.Errorf("Expecting %s %g with %d members, got %s with %d members", exp[i].hex, exp[i].score, exp[i].members, c, len(c.Members))
+		}
+	}
+	if p[1].Members[0].Color.Hex() != "#343434" {
+		t.Errorf("Expecting the representative as first member, got %s", p[1].Members[0])
+	}
+	if len(p.Trim(2)) != 2 {
+		t.Error("Expecting Trim to keep 2 clusters")
+	}
+	if len(Group(cml, nil)) != 6 {
+		t.Error("Expecting no clustering without a tolerance")
+	}
+}
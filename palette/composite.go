@@ -0,0 +1,106 @@
+package palette
+
+import (
+	"math"
+	"regexp"
+
+	"github.com/nochso/colourl/css"
+)
+
+// Modes of combining the scores of a Composite.
+const (
+	// ModeSum adds up the weighted scores.
+	ModeSum = "sum"
+	// ModeProduct multiplies the scores raised to the power of their weight.
+	ModeProduct = "product"
+)
+
+// Weighted is a Scorer with a weight used by Composite.
+type Weighted struct {
+	Scorer Scorer
+	Weight float64
+}
+
+// Composite combines the scores of several weighted Scorers.
+// With ModeSum the score is the sum of each score times its weight.
+// With ModeProduct the score is the product of each score to the power of its weight,
+// so a weight of 0.5 dampens a Scorer and any score of 0 drops a mention.
+// Mentions dropped by any of the Filters score 0.
+type Composite struct {
+	// Mode is ModeSum or ModeProduct. Falls back on ModeSum if empty.
+	Mode string
+	// Scorers to combine. Without Scorers mentions that pass all filters score 1.
+	Scorers []Weighted
+	Filters []*Filter
+}
+
+// Score implements palette.Scorer
+func (sc *Composite) Score(cml *css.CML, cm *css.ColorMention) float64 {
+	for _, f := range sc.Filters {
+		if f.Drop(cml, cm) {
+			return 0
+		}
+	}
+	if len(sc.Scorers) == 0 {
+		return (&SumScore{}).Score(cml, cm)
+	}
+	if sc.Mode == ModeProduct {
+		score := 1.0
+		for _, w := range sc.Scorers {
+			s := w.Scorer.Score(cml, cm)
+			if s <= 0 {
+				return 0
+			}
+			score *= math.Pow(s, w.Weight)
+		}
+		return score
+	}
+	score := 0.0
+	for _, w := range sc.Scorers {
+		score += w.Scorer.Score(cml, cm) * w.Weight
+	}
+	return score
+}
+
+var _ Scorer = (*Composite)(nil)
+
+// Filter drops mentions matching all of its criteria. Criteria that are not set match any mention.
+type Filter struct {
+	// Property matches the property of a mention, e.g. `^border`.
+	Property *regexp.Regexp
+	// Selector matches the selector of a mention, e.g. `:hover`.
+	Selector *regexp.Regexp
+	// File matches the URL of the file a mention was found in, e.g. `/vendor/`.
+	// Mentions without a css.Location never match.
+	File *regexp.Regexp
+	// AtRule matches any at-rule enclosing a mention, e.g. `^@media print`.
+	AtRule *regexp.Regexp
+	// Source matches the name of the css.Source of a mention exactly, e.g. `icon`.
+	Source string
+}
+
+// Drop reports whether a mention matches all criteria of the filter.
+func (f *Filter) Drop(cml *css.CML, cm *css.ColorMention) bool {
+	if f.Property != nil && !f.Property.MatchString(cm.Property) {
+		return false
+	}
+	if f.Selector != nil && !f.Selector.MatchString(cm.Selector) {
+		return false
+	}
+	if f.File != nil && (cm.Location == nil || cm.Location.URL == nil || !f.File.MatchString(cm.Location.URL.String())) {
+		return false
+	}
+	if f.AtRule != nil {
+		matched := false
+		for _, a := range cm.AtRules {
+			matched = matched || f.AtRule.MatchString(a.String())
+		}
+		if !matched {
+			return false
+		}
+	}
+	if f.Source != "" && f.Source != cm.Source.String() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package palette
+
+import (
+	"math"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/nochso/colourl/css"
+)
+
+func TestComposite(t *testing.T) {
+	cml := &css.CML{Mentions: css.ParseStylesheet(`body { background: #000; color: #111 }
+td { border-color: #222 }
+@media print { body { color: #333 } }`)}
+	var tests = []struct {
+		sc  *Composite
+		exp []float64
+	}{
+		{&Composite{}, []float64{1, 1, 1, 1}},
+		{&Composite{Scorers: []Weighted{{&RoleScore{}, 0.5}, {&SumScore{}, 2}}}, []float64{3.5, 3.5, 2.5, 3.5}},
+		{&Composite{Mode: ModeProduct, Scorers: []Weighted{{&RoleScore{}, 2}, {&SpecificityScore{}, 0.5}}}, []float64{9 * math.Sqrt(8), 9 * math.Sqrt(8), math.Sqrt(8), 9 * math.Sqrt(8)}},
+		{&Composite{Filters: []*Filter{{AtRule: regexp.MustCompile(`^@media print`)}}}, []float64{1, 1, 1, 0}},
+		{&Composite{Filters: []*Filter{{Property: regexp.MustCompile(`^border`), Selector: regexp.MustCompile(`^body$`)}}}, []float64{1, 1, 1, 1}},
+		{&Composite{Filters: []*Filter{{Property: regexp.MustCompile(`^color$`), Selector: regexp.MustCompile(`^body$`)}}}, []float64{1, 0, 1, 0}},
+		{&Composite{Filters: []*Filter{{Source: "icon"}}}, []float64{1, 1, 1, 1}},
+	}
+	for ti, tt := range tests {
+		for i, cm := range cml.Mentions {
+			if s := tt.sc.Score(cml, cm); math.Abs(s-tt.exp[i]) > 1e-9 {
+				t.Errorf("Test #%d expected score %g for ColorMention #%d, got %g", ti, tt.exp[i], i, s)
+			}
+		}
+	}
+}
+
+func TestFilter_File(t *testing.T) {
+	vendor, _ := url.Parse("https://example.com/vendor/bootstrap.css")
+	f := &Filter{File: regexp.MustCompile(`/vendor/`)}
+	if !f.Drop(nil, &css.ColorMention{Location: &css.Location{URL: vendor}}) {
+		t.Error("Expecting mentions of vendor files to be dropped")
+	}
+	if f.Drop(nil, &css.ColorMention{}) {
+		t.Error("Expecting mentions without a location to be kept")
+	}
+}
@@ -0,0 +1,66 @@
+package palette
+
+import (
+	"github.com/nochso/colourl/css"
+)
+
+// Defaults of ContentScore.
+const (
+	DefaultTextUnit    = 20
+	DefaultElementUnit = 4
+	DefaultProminence  = 3.0
+)
+
+// ContentScore wraps another Scorer and weighs colors by the amount of visible content they affect.
+// It relies on the Content measured by css.ParsePage.
+//
+// Text and elements, including the matched elements themselves, are converted to points.
+// Content at the top of a page counts up to twice as much as content at the bottom, and
+// content within header, navigation or hero areas is multiplied by Prominence.
+// The score of the wrapped Scorer is multiplied by the points, so rules matching
+// no element are ignored. Colors without measured content keep the score of the wrapped Scorer.
+type ContentScore struct {
+	// Scorer for mentions before weighing their content. Falls back on palette.SumScore if nil.
+	Scorer Scorer
+	// TextUnit is the amount of characters worth one point. Falls back on DefaultTextUnit if 0 or less.
+	TextUnit int
+	// ElementUnit is the amount of elements worth one point. Falls back on DefaultElementUnit if 0 or less.
+	ElementUnit int
+	// Prominence multiplies the points of prominent content. Falls back on DefaultProminence if 0 or less.
+	Prominence float64
+}
+
+// Score implements palette.Scorer
+func (sc *ContentScore) Score(cml *css.CML, cm *css.ColorMention) float64 {
+	var s float64
+	if sc.Scorer == nil {
+		s = (&SumScore{}).Score(cml, cm)
+	} else {
+		s = sc.Scorer.Score(cml, cm)
+	}
+	c := cm.Content
+	if c == nil {
+		return s
+	}
+	points := float64(c.Text)/float64(orDefault(sc.TextUnit, DefaultTextUnit)) +
+		float64(c.Descendants+cm.Elements)/float64(orDefault(sc.ElementUnit, DefaultElementUnit))
+	points *= 2 - c.Position
+	if c.Prominent {
+		prominence := sc.Prominence
+		if prominence <= 0 {
+			prominence = DefaultProminence
+		}
+		points *= prominence
+	}
+	return s * points
+}
+
+var _ Scorer = (*ContentScore)(nil)
+
+// orDefault returns v or the default if v is 0 or less.
+func orDefault(v, def int) int {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
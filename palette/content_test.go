@@ -0,0 +1,40 @@
+package palette
+
+import (
+	"testing"
+
+	"github.com/nochso/colourl/css"
+	"github.com/nochso/colourl/page"
+)
+
+func TestContentScore(t *testing.T) {
+	cml, err := css.ParsePage(&page.Page{HTML: &page.File{Body: `<html><head><style>
+header { background: #008080 }
+footer { background: #808080 }
+footer a { color: #808080 }
+footer p { border-color: #808080 }
+.unused { color: #ff0000 }
+</style></head><body>
+<header><h1>A big teal header with a short but prominent title</h1></header>
+<main><p>Some uncolored content</p></main>
+<footer><p><a href="/">Imprint</a></p></footer>
+</body></html>`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := Group(cml, &SumScore{})
+	if p[0].Color.Hex() != "#808080" {
+		t.Fatalf("Bad test setup: expecting gray to be most frequent, got %s", p[0])
+	}
+	p = Group(cml, &ContentScore{})
+	if len(p) != 2 {
+		t.Fatalf("Expecting colors of unused rules to be ignored, got:\n%s", p)
+	}
+	if p[0].Color.Hex() != "#008080" {
+		t.Errorf("Expecting the prominent teal header to win, got:\n%s", p)
+	}
+	icon := &css.ColorMention{Elements: 0}
+	if s := (&ContentScore{}).Score(cml, icon); s != 1 {
+		t.Errorf("Expecting colors without measured content to keep their score, got %g", s)
+	}
+}
@@ -25,6 +25,16 @@ type PaintJob struct {
 	Width, Height int
 	// Maximum amount of unique colors to keep
 	Max int
+	// Trim options for picking the colors to keep. Falls back on DefaultTrimOptions if nil.
+	Trim *TrimOptions
+}
+
+// trim returns the colors of a Palette to paint.
+func (job PaintJob) trim(p *Palette) Palette {
+	if job.Trim == nil {
+		return p.Trim(job.Max)
+	}
+	return p.TrimWith(job.Max, *job.Trim)
 }
 
 // BandPainter draws a rectangle for each color.
@@ -35,19 +45,21 @@ type BandPainter struct {
 
 // Paint implements Painter
 func (painter *BandPainter) Paint(p *Palette, s *svg.SVG, job PaintJob) {
-	pal := p.Trim(job.Max)
+	pal := job.trim(p)
 	sum := pal.ScoreSum()
 	offset := 0.0
 	for _, c := range pal {
-		if painter.vertical {
-			height := float64(c.Score) / float64(sum) * float64(job.Height)
-			s.Rect(0, int(offset), job.Width, job.Height-int(offset), "fill:"+c.Color.Hex())
-			offset += height
-		} else {
-			width := float64(c.Score) / float64(sum) * float64(job.Width)
-			s.Rect(int(offset), 0, job.Width-int(offset), job.Height, "fill:"+c.Color.Hex())
-			offset += width
-		}
+		titled(s, c, func() {
+			if painter.vertical {
+				height := c.Score / sum * float64(job.Height)
+				s.Rect(0, int(offset), job.Width, job.Height-int(offset), "fill:"+c.Color.Hex())
+				offset += height
+			} else {
+				width := c.Score / sum * float64(job.Width)
+				s.Rect(int(offset), 0, job.Width-int(offset), job.Height, "fill:"+c.Color.Hex())
+				offset += width
+			}
+		})
 	}
 }
 
@@ -60,7 +72,7 @@ type CirclePainter struct {
 
 // Paint implements Painter
 func (painter *CirclePainter) Paint(p *Palette, s *svg.SVG, job PaintJob) {
-	pal := p.Trim(job.Max)
+	pal := job.trim(p)
 	sum := pal.ScoreSum()
 	r := float64(job.Width / 2.0)
 	if painter.reverse {
@@ -70,11 +82,26 @@ func (painter *CirclePainter) Paint(p *Palette, s *svg.SVG, job PaintJob) {
 		}
 	}
 	for _, c := range pal {
-		s.Circle(job.Width/2, job.Height/2, int(r), "fill:"+c.Color.Hex())
-		r -= float64(c.Score) / float64(sum) * float64(job.Width/2)
+		titled(s, c, func() {
+			s.Circle(job.Width/2, job.Height/2, int(r), "fill:"+c.Color.Hex())
+		})
+		r -= c.Score / sum * float64(job.Width/2)
 	}
 }
 
+// titled draws the shape of a color. Named colors are wrapped in a group with a
+// title for screen readers and tooltips.
+func titled(s *svg.SVG, c *ColorScore, draw func()) {
+	if c.Name == "" {
+		draw()
+		return
+	}
+	s.Group()
+	s.Title(c.Name)
+	draw()
+	s.Gend()
+}
+
 // Paint a Palette using a Painter and PaintJob.
 func (pal *Palette) Paint(painter Painter, job PaintJob) []byte {
 	buf := new(bytes.Buffer)
@@ -84,3 +111,27 @@ func (pal *Palette) Paint(painter Painter, job PaintJob) []byte {
 	canvas.End()
 	return buf.Bytes()
 }
+
+// schemeStyle shows either the light or dark group of a SVG depending on the preferred color scheme.
+const schemeStyle = `.dark { display: none }
+@media (prefers-color-scheme: dark) {
+	.light { display: none }
+	.dark { display: inline }
+}`
+
+// PaintSchemes paints a light and a dark Palette into a single SVG.
+// The SVG switches between them using a `prefers-color-scheme` media query.
+func PaintSchemes(light, dark Palette, painter Painter, job PaintJob) []byte {
+	buf := new(bytes.Buffer)
+	canvas := svg.New(buf)
+	canvas.Start(job.Width, job.Height)
+	canvas.Style("text/css", schemeStyle)
+	canvas.Group(`class="light"`)
+	painter.Paint(&light, canvas, job)
+	canvas.Gend()
+	canvas.Group(`class="dark"`)
+	painter.Paint(&dark, canvas, job)
+	canvas.Gend()
+	canvas.End()
+	return buf.Bytes()
+}
@@ -13,8 +13,16 @@ import (
 
 // ColorScore is the resulting score for a specific color.
 type ColorScore struct {
-	Score int
+	Score float64
 	Color *colorful.Color
+	// Name of the nearest named color. Empty unless set by Palette.Name.
+	Name string
+	// Members of a cluster of similar colors sorted by score, including the representative Color.
+	// nil unless created by Palette.Cluster.
+	Members []*ColorScore
+	// Theme is true if the color is declared as a theme color, e.g. by `<meta name="theme-color">`
+	// or a Web App Manifest. Within a cluster it is true if any member is a theme color.
+	Theme bool
 }
 
 // Palette is a list of Colors sorted by score.
@@ -22,33 +30,9 @@ type ColorScore struct {
 type Palette []*ColorScore
 
 // Trim returns a new Palette with max amount of colors.
-// Boring colors are ignored if possible.
+// Boring colors are ignored if possible, see DefaultTrimOptions.
 func (p Palette) Trim(max int) Palette {
-	white, _ := colorful.Hex("#ffffff")
-	black, _ := colorful.Hex("#000000")
-	count := len(p)
-	max = minInt(max, count)
-	scores := make([]*ColorScore, max)
-	scoreCount := 0
-	for i, c := range p {
-		if scoreCount == max {
-			break
-		}
-		if count-i > max-scoreCount {
-			// Ignore gray/low saturation
-			_, s, _ := c.Color.Hsv()
-			if s < 0.1 {
-				continue
-			}
-			// Ignore almost white or black
-			if c.Color.DistanceCIE76(white) <= 0.05 || c.Color.DistanceCIE76(black) <= 0.05 {
-				continue
-			}
-		}
-		scores[scoreCount] = c
-		scoreCount++
-	}
-	return scores
+	return p.TrimWith(max, DefaultTrimOptions)
 }
 
 func minInt(a, b int) int {
@@ -59,8 +43,8 @@ func minInt(a, b int) int {
 }
 
 // ScoreSum returns the sum of the score of all ColorScores.
-func (p Palette) ScoreSum() int {
-	sum := 0
+func (p Palette) ScoreSum() float64 {
+	sum := 0.0
 	for _, cs := range p {
 		sum += cs.Score
 	}
@@ -88,27 +72,98 @@ func (p Palette) String() string {
 }
 
 func (c ColorScore) String() string {
-	return fmt.Sprintf("%s %d", c.Color.Hex(), c.Score)
+	if c.Name != "" {
+		return fmt.Sprintf("%s %s %g", c.Color.Hex(), c.Name, c.Score)
+	}
+	return fmt.Sprintf("%s %g", c.Color.Hex(), c.Score)
+}
+
+// Name every color of the Palette after the nearest color of a dictionary,
+// e.g. css.CSSNames() or css.ExtendedNames().
+func (p Palette) Name(d css.Dictionary) {
+	for _, c := range p {
+		nc, _, ok := d.Nearest(*c.Color)
+		if ok {
+			c.Name = nc.Name
+		}
+	}
 }
 
 // New creates a Palette from a websites CSS colors.
 // Colors are sorted by their score.
 func New(ctx context.Context, url string, scorer Scorer) (Palette, error) {
+	return NewWith(ctx, url, scorer, GroupOptions{})
+}
+
+// NewWith creates a Palette like palette.New, grouping colors using GroupOptions.
+func NewWith(ctx context.Context, url string, scorer Scorer, opts GroupOptions) (Palette, error) {
+	cml, err := fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	return GroupWith(cml, scorer, opts), nil
+}
+
+// NewSchemes creates a Palette for both the light and dark color scheme of a website.
+// Rules within `prefers-color-scheme` media queries and arguments of light-dark()
+// only count towards their scheme. Print styles and animations are ignored.
+// Both palettes are equal if a website has no dark color scheme.
+func NewSchemes(ctx context.Context, url string, scorer Scorer) (light, dark Palette, err error) {
+	return NewSchemesWith(ctx, url, scorer, GroupOptions{})
+}
+
+// NewSchemesWith creates palettes like palette.NewSchemes, grouping colors using GroupOptions.
+func NewSchemesWith(ctx context.Context, url string, scorer Scorer, opts GroupOptions) (light, dark Palette, err error) {
+	cml, err := fetch(ctx, url)
+	if err != nil {
+		return nil, nil, err
+	}
+	light = GroupWith(cml, &ContextScore{Scorer: scorer, Scheme: "light"}, opts)
+	dark = GroupWith(cml, &ContextScore{Scorer: scorer, Scheme: "dark"}, opts)
+	return light, dark, nil
+}
+
+// fetch downloads a website including its background images and extracts its colors.
+func fetch(ctx context.Context, url string) (*css.CML, error) {
 	pg, err := page.New(ctx, url)
 	if err != nil {
 		return nil, err
 	}
-	cml, err := css.ParsePage(pg)
+	urls, err := css.ImageURLs(pg)
 	if err != nil {
 		return nil, err
 	}
-	return Group(cml, scorer), nil
+	pg.AddImages(ctx, urls)
+	return css.ParsePage(pg)
+}
+
+// GroupOptions control how translucent and similar colors are treated by palette.GroupWith.
+type GroupOptions struct {
+	// Backdrop that translucent colors are composited over.
+	// If nil, colors are grouped as is and their alpha is ignored.
+	Backdrop *colorful.Color
+	// Mentions with an alpha lower than MinAlpha are ignored.
+	// Fully transparent mentions like `transparent` are always ignored.
+	MinAlpha float64
+	// Tolerance clusters colors within a CIEDE2000 distance, see Palette.Cluster.
+	// If zero, colors are only grouped by their exact hex value.
+	Tolerance float64
 }
 
 // Group a CML (ColorMention list) as a Palette.
 // Mentions are grouped by color and scored with the specified Scorer implementation.
 // If scorer is nil, it will fall back on palette.SumScore
 func Group(cml *css.CML, scorer Scorer) Palette {
+	return GroupWith(cml, scorer, GroupOptions{})
+}
+
+// GroupWith groups a CML like palette.Group using GroupOptions.
+// Translucent colors are dropped or composited over a backdrop before grouping by color.
+// Scores of colors from icons and images are scaled by their share of pixels,
+// so each icon or image counts like css.MaxIconColors or css.MaxImageColors mentions
+// split among its colors.
+// Similar colors are clustered if a Tolerance is set.
+func GroupWith(cml *css.CML, scorer Scorer, opts GroupOptions) Palette {
 	pal := Palette{}
 	if scorer == nil {
 		scorer = &SumScore{}
@@ -116,15 +171,78 @@ func Group(cml *css.CML, scorer Scorer) Palette {
 	// Map hex color to index in Palette
 	keys := map[string]int{}
 	for _, cm := range cml.Mentions {
+		if cm.Alpha <= 0 || cm.Alpha < opts.MinAlpha {
+			continue
+		}
 		score := scorer.Score(cml, cm)
-		k, ok := keys[cm.Color.Hex()]
-		if ok { // Add score to known color
+		switch cm.Source {
+		case css.SourceIcon:
+			score *= cm.Weight * float64(css.MaxIconColors)
+		case css.SourceImage:
+			score *= cm.Weight * float64(css.MaxImageColors)
+		}
+		if score <= 0 {
+			continue
+		}
+		c := opts.composite(cm)
+		k, ok := keys[c.Hex()]
+		if !ok { // Append new ColorScore and remember its position by color
+			pal = append(pal, &ColorScore{Color: c})
+			k = len(pal) - 1
+			keys[c.Hex()] = k
+		}
+		pal[k].Score += score
+		pal[k].Theme = pal[k].Theme || RoleOf(cm) == RoleTheme
+	}
+	if opts.Tolerance > 0 {
+		return pal.Cluster(opts.Tolerance)
+	}
+	sort.Sort(pal)
+	return pal
+}
+
+// composite returns the color of a mention blended over the backdrop according to its alpha.
+func (opts GroupOptions) composite(cm *css.ColorMention) *colorful.Color {
+	if opts.Backdrop == nil || cm.Alpha >= 1 {
+		return cm.Color
+	}
+	c := cm.Color.BlendRgb(*opts.Backdrop, 1-cm.Alpha)
+	return &c
+}
+
+// NewText creates a Palette from the colors of the visible text of a website.
+// Foreground and background colors are weighted by the length of the text using them.
+// See css.Cascade for the limitations of computing the effective colors.
+func NewText(ctx context.Context, url string, scheme string) (Palette, error) {
+	pg, err := page.New(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	texts, err := css.ComputeText(pg, scheme)
+	if err != nil {
+		return nil, err
+	}
+	return GroupText(texts), nil
+}
+
+// GroupText groups the effective colors of text nodes as a Palette.
+// Both the foreground and background color of a text node score its length.
+func GroupText(texts []*css.TextColor) Palette {
+	pal := Palette{}
+	// Map hex color to index in Palette
+	keys := map[string]int{}
+	add := func(c colorful.Color, score float64) {
+		k, ok := keys[c.Hex()]
+		if ok {
 			pal[k].Score += score
-		} else { // Append new ColorScore and remember its position by color
-			cs := &ColorScore{score, cm.Color}
-			pal = append(pal, cs)
-			keys[cm.Color.Hex()] = len(pal) - 1
+			return
 		}
+		pal = append(pal, &ColorScore{Score: score, Color: &c})
+		keys[c.Hex()] = len(pal) - 1
+	}
+	for _, t := range texts {
+		add(t.Foreground, float64(t.Length))
+		add(t.Background, float64(t.Length))
 	}
 	sort.Sort(pal)
 	return pal
@@ -1,13 +1,19 @@
 package palette
 
 import (
+	"bytes"
 	"context"
+	"image"
+	imagecolor "image/color"
+	"image/png"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/lucasb-eyer/go-colorful"
 	"github.com/nochso/colourl/css"
+	"github.com/nochso/colourl/page"
 )
 
 func serve() *httptest.Server {
@@ -23,7 +29,7 @@ func TestNew(t *testing.T) {
 	}
 	var exp = []struct {
 		hex   string
-		score int
+		score float64
 	}{
 		{"#000102", 2},
 		{"#ff0000", 1},
@@ -31,7 +37,7 @@ func TestNew(t *testing.T) {
 	}
 	for i, c := range p {
 		if c.Score != exp[i].score {
-			t.Errorf("Expecting score %d for color %s, got %d", exp[i].score, c.Color.Hex(), c.Score)
+			t.Errorf("Expecting score %g for color %s, got %g", exp[i].score, c.Color.Hex(), c.Score)
 		}
 		if c.Color.Hex() != exp[i].hex {
 			t.Errorf("Expecting color %s, got %s", exp[i].hex, c.Color.Hex())
@@ -74,10 +80,179 @@ func TestNew_ErrorGET(t *testing.T) {
 func TestGroup_ScorerDefaultsToSumScorer(t *testing.T) {
 	c := colorful.FastHappyColor()
 	cml := &css.CML{
-		Mentions: []*css.ColorMention{{Color: &c}},
+		Mentions: []*css.ColorMention{{Color: &c, Alpha: 1}},
 	}
 	p := Group(cml, nil)
 	if p[0].Score != 1 {
-		t.Fatalf("Expecting score of 1, got %d", p[0].Score)
+		t.Fatalf("Expecting score of 1, got %g", p[0].Score)
+	}
+}
+
+func TestGroupWith(t *testing.T) {
+	white, _ := colorful.Hex("#ffffff")
+	black, _ := colorful.Hex("#000000")
+	cml := &css.CML{
+		Mentions: []*css.ColorMention{
+			{Color: &black, Alpha: 1},
+			{Color: &black, Alpha: 0.5},
+			{Color: &black, Alpha: 0.01},
+		},
+	}
+	var tests = []struct {
+		opts GroupOptions
+		exp  []string
+	}{
+		{GroupOptions{}, []string{"#000000 3"}},
+		{GroupOptions{MinAlpha: 0.05}, []string{"#000000 2"}},
+		{GroupOptions{Backdrop: &white, MinAlpha: 0.05}, []string{"#808080 1", "#000000 1"}},
+	}
+	for ti, tt := range tests {
+		p := GroupWith(cml, nil, tt.opts)
+		if len(p) != len(tt.exp) {
+			t.Fatalf("Test #%d expected %d colors, got %d", ti, len(tt.exp), len(p))
+		}
+		for i, c := range p {
+			if c.String() != tt.exp[i] {
+				t.Errorf("Test #%d expected %s, got %s", ti, tt.exp[i], c)
+			}
+		}
+	}
+}
+
+func TestGroup_IgnoresTransparent(t *testing.T) {
+	cml := &css.CML{Mentions: css.ParseStylesheet(`a { background: transparent } b { background-color: transparent } c { color: red }`)}
+	p := Group(cml, nil)
+	if len(p) != 1 || p[0].String() != "#ff0000 1" {
+		t.Errorf("Expecting only #ff0000 1, got %v", p)
+	}
+	if p = p.Trim(5); len(p) != 1 || p[0].Color.Hex() != "#ff0000" {
+		t.Errorf("Expecting only #ff0000 after Trim, got %v", p)
+	}
+}
+
+// encodePNG returns a PNG w pixels wide filled row by row with pixels.
+func encodePNG(t *testing.T, w int, pixels ...imagecolor.NRGBA) string {
+	img := image.NewNRGBA(image.Rect(0, 0, w, (len(pixels)+w-1)/w))
+	for i, c := range pixels {
+		img.SetNRGBA(i%w, i/w, c)
+	}
+	var buf bytes.Buffer
+	err := png.Encode(&buf, img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestGroup_WeighsIconColors(t *testing.T) {
+	pixels := []imagecolor.NRGBA{{B: 0xff, A: 0xff}}
+	for len(pixels) < 16 {
+		pixels = append(pixels, imagecolor.NRGBA{R: 0xff, G: 0x66, A: 0xff})
+	}
+	cms, err := css.ParseIcon(&page.File{Body: encodePNG(t, 4, pixels...)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := Group(&css.CML{Mentions: cms}, nil)
+	exp := []string{"#ff6600 7.5", "#0000ff 0.5"}
+	if len(p) != len(exp) {
+		t.Fatalf("Expecting %d colors, got %d", len(exp), len(p))
+	}
+	for i, c := range p {
+		if c.String() != exp[i] {
+			t.Errorf("Expecting %s, got %s", exp[i], c)
+		}
+	}
+}
+
+func TestGroup_WeighsImageColors(t *testing.T) {
+	red, _ := colorful.Hex("#ff0000")
+	blue, _ := colorful.Hex("#0000ff")
+	cml := &css.CML{Mentions: []*css.ColorMention{
+		{Color: &blue, Alpha: 1, Source: css.SourceImage, Weight: 0.2},
+		{Color: &red, Alpha: 1, Source: css.SourceImage, Weight: 0.8},
+	}}
+	p := Group(cml, nil)
+	if len(p) != 2 || p[0].String() != "#ff0000 4" || p[1].String() != "#0000ff 1" {
+		t.Errorf("Expecting image colors scored by their weight, got %v", p)
+	}
+}
+
+func TestNewSchemes(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	light, dark, err := NewSchemes(context.Background(), s.URL+"/schemes.html", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if light.String() != "1 #ffffff 1\n2 #ff0000 1\n" {
+		t.Errorf("Unexpected light palette:\n%s", light)
+	}
+	if dark.String() != "1 #ff0000 1\n2 #00ff00 1\n3 #000000 1\n" {
+		t.Errorf("Unexpected dark palette:\n%s", dark)
+	}
+	b := string(PaintSchemes(light, dark, &BandPainter{}, PaintJob{Width: 10, Height: 10, Max: 5}))
+	if !strings.Contains(b, "prefers-color-scheme: dark") || !strings.Contains(b, `class="dark"`) {
+		t.Errorf("Expecting SVG to switch palettes by color scheme, got %s", b)
+	}
+}
+
+func TestNewWith_Tolerance(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	opts := GroupOptions{Tolerance: 2}
+	p, err := NewWith(context.Background(), s.URL+"/mixed.html", nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p) != 1 || p[0].Score != 4 || len(p[0].Members) != 3 {
+		t.Errorf("Expecting a single cluster of 3 colors with 4 mentions, got:\n%s", p)
+	}
+	light, dark, err := NewSchemesWith(context.Background(), s.URL+"/schemes.html", nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(light) != 1 || light[0].Score != 2 || len(dark) != 1 || dark[0].Score != 3 {
+		t.Errorf("Expecting a single cluster per color scheme, got:\n%s\n%s", light, dark)
+	}
+}
+
+func TestNewText(t *testing.T) {
+	s := serve()
+	defer s.Close()
+	p, err := NewText(context.Background(), s.URL+"/text.html", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exp = []struct {
+		hex   string
+		score float64
+	}{
+		{"#ffffff", 37},
+		{"#333333", 26},
+		{"#000000", 6},
+		{"#ff0000", 5},
+	}
+	if len(p) != len(exp) {
+		t.Fatalf("Expecting %d colors, got %d:\n%s", len(exp), len(p), p)
+	}
+	for i, c := range p {
+		if c.Score != exp[i].score || c.Color.Hex() != exp[i].hex {
+			t.Errorf("Expecting %s %g, got %s", exp[i].hex, exp[i].score, c)
+		}
+	}
+}
+
+func TestPalette_Name(t *testing.T) {
+	red, _ := colorful.Hex("#fe0000")
+	blue, _ := colorful.Hex("#6495ed")
+	p := Palette{{Score: 2, Color: &red}, {Score: 1, Color: &blue}}
+	p.Name(css.CSSNames())
+	if p.String() != "1 #fe0000 red 2\n2 #6495ed cornflowerblue 1\n" {
+		t.Errorf("Unexpected named Palette:\n%s", p)
+	}
+	b := string(p.Paint(&BandPainter{}, PaintJob{Width: 10, Height: 10, Max: 5}))
+	if !strings.Contains(b, "<title>cornflowerblue</title>") {
+		t.Errorf("Expecting titled colors in SVG, got %s", b)
 	}
 }
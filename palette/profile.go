@@ -0,0 +1,176 @@
+package palette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/nochso/colourl/css"
+	"gopkg.in/yaml.v2"
+)
+
+// Profile is a named Scorer defined in JSON or YAML.
+// See palette/test/profiles.yaml for an example.
+type Profile struct {
+	Name             string `json:"name" yaml:"name"`
+	ScorerDefinition `yaml:",inline"`
+}
+
+// ScorerDefinition describes a Scorer and its options.
+// Options that do not apply to the type are ignored.
+type ScorerDefinition struct {
+	// Type of Scorer: frequency, context, specificity, role, content, selector or composite.
+	Type string `json:"type" yaml:"type"`
+	// Weight within a composite. Falls back on 1 if not set.
+	Weight *float64 `json:"weight" yaml:"weight"`
+	// Scorer wrapped by context, specificity, role, content and selector.
+	Scorer *ScorerDefinition `json:"scorer" yaml:"scorer"`
+
+	// Options of ContextScore
+	Print      bool   `json:"print" yaml:"print"`
+	Keyframes  bool   `json:"keyframes" yaml:"keyframes"`
+	Scheme     string `json:"scheme" yaml:"scheme"`
+	DropUnused bool   `json:"dropUnused" yaml:"dropUnused"`
+	// Max of SpecificityScore
+	Max int `json:"max" yaml:"max"`
+	// Weights of RoleScore
+	Roles map[Role]float64 `json:"roles" yaml:"roles"`
+	// Options of ContentScore
+	TextUnit    int     `json:"textUnit" yaml:"textUnit"`
+	ElementUnit int     `json:"elementUnit" yaml:"elementUnit"`
+	Prominence  float64 `json:"prominence" yaml:"prominence"`
+	// Weights of SelectorScore
+	Selector *SelectorWeights `json:"selector" yaml:"selector"`
+	// Options of Composite
+	Mode    string              `json:"mode" yaml:"mode"`
+	Scorers []*ScorerDefinition `json:"scorers" yaml:"scorers"`
+	Filters []*FilterDefinition `json:"filters" yaml:"filters"`
+}
+
+// FilterDefinition describes a Filter using regular expressions.
+type FilterDefinition struct {
+	Property string `json:"property" yaml:"property"`
+	Selector string `json:"selector" yaml:"selector"`
+	File     string `json:"file" yaml:"file"`
+	AtRule   string `json:"atRule" yaml:"atRule"`
+	Source   string `json:"source" yaml:"source"`
+}
+
+// ParseProfiles parses a list of profiles from JSON or YAML.
+func ParseProfiles(b []byte) ([]*Profile, error) {
+	var profiles []*Profile
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("[")) {
+		err = json.Unmarshal(b, &profiles)
+	} else {
+		err = yaml.Unmarshal(b, &profiles)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range profiles {
+		if p.Name == "" {
+			return nil, fmt.Errorf("profile #%d is missing a name", i+1)
+		}
+	}
+	return profiles, nil
+}
+
+// Build creates the Scorer described by a definition.
+func (d *ScorerDefinition) Build() (Scorer, error) {
+	var inner Scorer
+	if d.Scorer != nil {
+		var err error
+		inner, err = d.Scorer.Build()
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch d.Type {
+	case "frequency", "":
+		return &SumScore{}, nil
+	case "context":
+		return &ContextScore{Scorer: inner, Print: d.Print, Keyframes: d.Keyframes, Scheme: d.Scheme, DropUnused: d.DropUnused}, nil
+	case "specificity":
+		return &SpecificityScore{Scorer: inner, Max: d.Max}, nil
+	case "role":
+		return &RoleScore{Scorer: inner, Weights: d.Roles}, nil
+	case "content":
+		return &ContentScore{Scorer: inner, TextUnit: d.TextUnit, ElementUnit: d.ElementUnit, Prominence: d.Prominence}, nil
+	case "selector":
+		return &SelectorScore{Scorer: inner, Weights: d.Selector}, nil
+	case "composite":
+		return d.buildComposite()
+	}
+	return nil, fmt.Errorf("unknown scorer type %q", d.Type)
+}
+
+// buildComposite creates a Composite including its filters.
+func (d *ScorerDefinition) buildComposite() (Scorer, error) {
+	if d.Mode != "" && d.Mode != ModeSum && d.Mode != ModeProduct {
+		return nil, fmt.Errorf("unknown composite mode %q", d.Mode)
+	}
+	c := &Composite{Mode: d.Mode}
+	for _, sd := range d.Scorers {
+		s, err := sd.Build()
+		if err != nil {
+			return nil, err
+		}
+		w := Weighted{Scorer: s, Weight: 1}
+		if sd.Weight != nil {
+			w.Weight = *sd.Weight
+		}
+		c.Scorers = append(c.Scorers, w)
+	}
+	for _, fd := range d.Filters {
+		f, err := fd.Build()
+		if err != nil {
+			return nil, err
+		}
+		c.Filters = append(c.Filters, f)
+	}
+	return c, nil
+}
+
+// Build compiles the regular expressions of a filter definition.
+// A filter must have at least one criterion and Source must name a css.Source.
+func (fd *FilterDefinition) Build() (*Filter, error) {
+	if *fd == (FilterDefinition{}) {
+		return nil, errors.New("filter without criteria")
+	}
+	if fd.Source != "" && !isSource(fd.Source) {
+		return nil, fmt.Errorf("unknown filter source %q", fd.Source)
+	}
+	f := &Filter{Source: fd.Source}
+	for _, re := range []struct {
+		expr string
+		dst  **regexp.Regexp
+	}{
+		{fd.Property, &f.Property},
+		{fd.Selector, &f.Selector},
+		{fd.File, &f.File},
+		{fd.AtRule, &f.AtRule},
+	} {
+		if re.expr == "" {
+			continue
+		}
+		compiled, err := regexp.Compile(re.expr)
+		if err != nil {
+			return nil, err
+		}
+		*re.dst = compiled
+	}
+	return f, nil
+}
+
+// isSource reports whether name is the name of a css.Source.
+func isSource(name string) bool {
+	for s := css.SourceDocument; s <= css.SourceImage; s++ {
+		if s.String() == name {
+			return true
+		}
+	}
+	return false
+}
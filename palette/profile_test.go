@@ -0,0 +1,80 @@
+package palette
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/nochso/colourl/css"
+)
+
+func TestParseProfiles(t *testing.T) {
+	b, err := ioutil.ReadFile("test/profiles.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	profiles, err := ParseProfiles(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) != 2 || profiles[0].Name != "brand" || profiles[1].Name != "visible text" {
+		t.Fatalf("Unexpected profiles: %+v", profiles)
+	}
+	s, err := profiles[0].Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := s.(*Composite)
+	if c.Mode != ModeProduct || len(c.Scorers) != 2 || len(c.Filters) != 2 {
+		t.Fatalf("Unexpected composite: %+v", c)
+	}
+	if c.Scorers[0].Weight != 1 || c.Scorers[1].Weight != 0.5 {
+		t.Errorf("Expecting weights 1 and 0.5, got %g and %g", c.Scorers[0].Weight, c.Scorers[1].Weight)
+	}
+	w := c.Scorers[1].Scorer.(*SelectorScore).Weights
+	if w.Identity != 8 || w.Base != DefaultSelectorWeights.Base {
+		t.Errorf("Expecting selector weights on top of the defaults, got %+v", w)
+	}
+	if c.Scorers[0].Scorer.(*RoleScore).Weights[RoleBorder] != 0.5 {
+		t.Error("Expecting a role weight of 0.5 for borders")
+	}
+	s, err = profiles[1].Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cs := s.(*ContentScore); cs.TextUnit != 10 || !cs.Scorer.(*ContextScore).DropUnused {
+		t.Errorf("Unexpected content scorer: %+v", cs)
+	}
+}
+
+func TestParseProfiles_JSON(t *testing.T) {
+	profiles, err := ParseProfiles([]byte(`[{"name": "borders", "type": "composite", "mode": "sum",
+	"scorers": [{"type": "role", "weight": 1.5, "roles": {"border": 2}}],
+	"filters": [{"source": "icon"}]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := profiles[0].Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cm := &css.ColorMention{Property: "border-color"}
+	if score := s.Score(nil, cm); score != 3 {
+		t.Errorf("Expecting score 3, got %g", score)
+	}
+	for _, invalid := range []string{
+		`[{"type": "role"}]`,
+		`[{"name": "x", "type": "unknown"}]`,
+		`[{"name": "x", "type": "composite", "mode": "average"}]`,
+		`[{"name": "x", "type": "composite", "filters": [{"selector": "("}]}]`,
+		`[{"name": "x", "type": "composite", "filters": [{}]}]`,
+		`[{"name": "x", "type": "composite", "filters": [{"source": "favicon"}]}]`,
+	} {
+		profiles, err := ParseProfiles([]byte(invalid))
+		if err == nil {
+			_, err = profiles[0].Build()
+		}
+		if err == nil {
+			t.Errorf("Expecting an error for %s", invalid)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package palette
+
+import (
+	"strings"
+
+	"github.com/nochso/colourl/css"
+)
+
+// Role of a color on a page, derived from the property it is used for.
+type Role string
+
+// Roles of colors classified by RoleOf.
+const (
+	// RoleBackground is a background or the canvas of an app
+	RoleBackground Role = "background"
+	// RoleText is the color of text and text decorations
+	RoleText Role = "text"
+	// RoleBorder is a border or column rule
+	RoleBorder Role = "border"
+	// RoleOutline is an outline, e.g. of focused elements
+	RoleOutline Role = "outline"
+	// RoleShadow is a box or text shadow
+	RoleShadow Role = "shadow"
+	// RoleFill is the fill or stroke of SVG shapes
+	RoleFill Role = "fill"
+	// RoleTheme is a theme color declared by meta elements or a Web App Manifest
+	RoleTheme Role = "theme"
+	// RoleOther is any other property, e.g. `caret-color` or an icon
+	RoleOther Role = "other"
+)
+
+// propertyRoles maps properties to their Role. Properties starting with
+// `border` or `outline` are matched by prefix.
+var propertyRoles = map[string]Role{
+	"background":                RoleBackground,
+	"background-color":          RoleBackground,
+	"background-image":          RoleBackground,
+	css.PropBgColor:             RoleBackground,
+	css.PropManifestBackground:  RoleBackground,
+	"color":                     RoleText,
+	"-webkit-text-fill-color":   RoleText,
+	"-webkit-text-stroke-color": RoleText,
+	"text-decoration":           RoleText,
+	"text-decoration-color":     RoleText,
+	"text-emphasis-color":       RoleText,
+	css.PropText:                RoleText,
+	css.PropLink:                RoleText,
+	css.PropVLink:               RoleText,
+	css.PropALink:               RoleText,
+	css.PropFontColor:           RoleText,
+	"column-rule":               RoleBorder,
+	"column-rule-color":         RoleBorder,
+	"box-shadow":                RoleShadow,
+	"text-shadow":               RoleShadow,
+	"fill":                      RoleFill,
+	"stroke":                    RoleFill,
+	"stop-color":                RoleFill,
+	"flood-color":               RoleFill,
+	"lighting-color":            RoleFill,
+	css.PropSVGFill:             RoleFill,
+	css.PropSVGStroke:           RoleFill,
+	css.PropSVGStopColor:        RoleFill,
+	css.PropSVGFloodColor:       RoleFill,
+	css.PropSVGLightingColor:    RoleFill,
+	css.PropSVGColor:            RoleFill,
+	css.PropThemeColor:          RoleTheme,
+	css.PropTileColor:           RoleTheme,
+	css.PropMaskIcon:            RoleTheme,
+	css.PropManifestTheme:       RoleTheme,
+}
+
+// RoleOf classifies the property of a ColorMention.
+func RoleOf(cm *css.ColorMention) Role {
+	property := strings.ToLower(cm.Property)
+	if r, ok := propertyRoles[property]; ok {
+		return r
+	}
+	switch {
+	case strings.HasPrefix(property, "border"):
+		return RoleBorder
+	case strings.HasPrefix(property, "outline"):
+		return RoleOutline
+	}
+	return RoleOther
+}
+
+// DefaultRoleWeights are the weights of RoleScore if none are set.
+// Backgrounds, text and declared theme colors define the look of a page the most.
+var DefaultRoleWeights = map[Role]float64{
+	RoleBackground: 3,
+	RoleText:       3,
+	RoleTheme:      4,
+	RoleFill:       2,
+	RoleBorder:     1,
+	RoleOutline:    1,
+	RoleShadow:     1,
+	RoleOther:      1,
+}
+
+// RoleScore wraps another Scorer and weighs colors by the Role of their property.
+type RoleScore struct {
+	// Scorer for mentions before weighing their role. Falls back on palette.SumScore if nil.
+	Scorer Scorer
+	// Weights multiply the score of each Role. A weight of 0 ignores a Role.
+	// Roles missing from Weights fall back on DefaultRoleWeights.
+	Weights map[Role]float64
+}
+
+// Score implements palette.Scorer
+func (sc *RoleScore) Score(cml *css.CML, cm *css.ColorMention) float64 {
+	role := RoleOf(cm)
+	weight, ok := sc.Weights[role]
+	if !ok {
+		weight = DefaultRoleWeights[role]
+	}
+	if weight <= 0 {
+		return 0
+	}
+	if sc.Scorer == nil {
+		return weight * (&SumScore{}).Score(cml, cm)
+	}
+	return weight * sc.Scorer.Score(cml, cm)
+}
+
+var _ Scorer = (*RoleScore)(nil)
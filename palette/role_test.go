@@ -0,0 +1,45 @@
+package palette
+
+import (
+	"testing"
+
+	"github.com/nochso/colourl/css"
+)
+
+func TestRoleOf(t *testing.T) {
+	cms, err := css.ParseHTML(`<meta name="theme-color" content="#fff"><style>
+body { background: #000 }
+a { color: red; border-top-color: blue; outline: 1px solid green; box-shadow: 0 0 1px gray; caret-color: pink }
+</style><svg><rect fill="#f00"/></svg>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []Role{RoleTheme, RoleBackground, RoleText, RoleBorder, RoleOutline, RoleShadow, RoleOther, RoleFill}
+	if len(cms) != len(exp) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(exp), len(cms))
+	}
+	for i, cm := range cms {
+		if r := RoleOf(cm); r != exp[i] {
+			t.Errorf("Expecting role %s for %s, got %s", exp[i], cm.Property, r)
+		}
+	}
+}
+
+func TestRoleScore(t *testing.T) {
+	cml := &css.CML{Mentions: css.ParseStylesheet(`body { background: #000; color: #111 } td { border-color: #222 }`)}
+	var tests = []struct {
+		sc  *RoleScore
+		exp []float64
+	}{
+		{&RoleScore{}, []float64{3, 3, 1}},
+		{&RoleScore{Weights: map[Role]float64{RoleBorder: 0, RoleText: 5}}, []float64{3, 5, 0}},
+		{&RoleScore{Scorer: &SpecificityScore{}}, []float64{24, 24, 8}},
+	}
+	for ti, tt := range tests {
+		for i, cm := range cml.Mentions {
+			if s := tt.sc.Score(cml, cm); s != tt.exp[i] {
+				t.Errorf("Test #%d expected score %g for ColorMention #%d, got %g", ti, tt.exp[i], i, s)
+			}
+		}
+	}
+}
@@ -6,9 +6,18 @@ import (
 
 // Scorer must return a score for a single ColorMention.
 // It is used by palette.Group to weigh and sort colors.
+// Mentions with a score of 0 or less are ignored.
 // The CML containing the ColorMention is also passed to allow access to the URL.
 type Scorer interface {
-	Score(cml *css.CML, cm *css.ColorMention) int
+	Score(cml *css.CML, cm *css.ColorMention) float64
+}
+
+// Scorers is a map of Scorer implementations with names as keys.
+var Scorers = map[string]Scorer{
+	"frequency":       &SumScore{},
+	"role":            &RoleScore{},
+	"selector":        &SelectorScore{},
+	"visible content": &ContentScore{},
 }
 
 // SumScore returns a score of 1 for every ColorMention.
@@ -16,8 +25,91 @@ type Scorer interface {
 type SumScore struct{}
 
 // Score implements palette.Scorer
-func (sc *SumScore) Score(cml *css.CML, cm *css.ColorMention) int {
+func (sc *SumScore) Score(cml *css.CML, cm *css.ColorMention) float64 {
 	return 1
 }
 
 var _ Scorer = (*SumScore)(nil)
+
+// ContextScore wraps another Scorer and ignores mentions based on their at-rule context.
+// By default print styles and animation keyframes are ignored.
+type ContextScore struct {
+	// Scorer for mentions that are not ignored. Falls back on palette.SumScore if nil.
+	Scorer Scorer
+	// Print keeps mentions within `@media print` rules.
+	Print bool
+	// Keyframes keeps mentions within `@keyframes` rules.
+	Keyframes bool
+	// Scheme keeps only mentions that apply to a color scheme.
+	// "light" ignores `prefers-color-scheme: dark` rules and vice versa.
+	// Arguments of light-dark() are picked according to the scheme used by the page.
+	// If empty, mentions of all color schemes are kept.
+	Scheme string
+	// DropUnused ignores mentions of rules that match no element of the page.
+	// Use with care for pages that build most of their HTML using JavaScript.
+	DropUnused bool
+}
+
+// Score implements palette.Scorer
+func (sc *ContextScore) Score(cml *css.CML, cm *css.ColorMention) float64 {
+	if !sc.Print && cm.IsPrint() {
+		return 0
+	}
+	if !sc.Keyframes && cm.InAtRule("@keyframes") {
+		return 0
+	}
+	if sc.DropUnused && cm.Unused {
+		return 0
+	}
+	if s := cm.ColorScheme(); sc.Scheme != "" && s != "" && s != sc.Scheme {
+		return 0
+	}
+	if sc.Scheme != "" && cm.Scheme != "" && cm.Scheme != cml.UsedScheme(sc.Scheme) {
+		return 0
+	}
+	if sc.Scorer == nil {
+		return (&SumScore{}).Score(cml, cm)
+	}
+	return sc.Scorer.Score(cml, cm)
+}
+
+var _ Scorer = (*ContextScore)(nil)
+
+// DefaultMaxSpecificity is the default of SpecificityScore.Max.
+const DefaultMaxSpecificity = 8
+
+// SpecificityScore wraps another Scorer and favors colors of selectors with a low specificity.
+// Broad selectors like `body` or `a` usually define the look of a whole page,
+// while specific ones like `#sidebar .widget a:hover` only apply to a small part of it.
+//
+// The specificity (a,b,c) is weighed as 4a+2b+c points, limited by Max.
+// The score of the wrapped Scorer is multiplied by Max+1 minus these points.
+// Inline styles are treated as the most specific selector. Colors without a
+// selector, e.g. from HTML attributes or icons, have a specificity of zero.
+type SpecificityScore struct {
+	// Scorer for mentions before weighing their specificity. Falls back on palette.SumScore if nil.
+	Scorer Scorer
+	// Max limits the points of a specificity. Falls back on DefaultMaxSpecificity if 0 or less.
+	Max int
+}
+
+// Score implements palette.Scorer
+func (sc *SpecificityScore) Score(cml *css.CML, cm *css.ColorMention) float64 {
+	max := sc.Max
+	if max <= 0 {
+		max = DefaultMaxSpecificity
+	}
+	points := 4*cm.Specificity[0] + 2*cm.Specificity[1] + cm.Specificity[2]
+	if cm.Inline || points > max {
+		points = max
+	}
+	var s float64
+	if sc.Scorer == nil {
+		s = (&SumScore{}).Score(cml, cm)
+	} else {
+		s = sc.Scorer.Score(cml, cm)
+	}
+	return s * float64(max+1-points)
+}
+
+var _ Scorer = (*SpecificityScore)(nil)
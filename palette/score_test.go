@@ -0,0 +1,72 @@
+package palette
+
+import (
+	"testing"
+
+	"github.com/nochso/colourl/css"
+)
+
+func TestContextScore(t *testing.T) {
+	cml := &css.CML{Mentions: css.ParseStylesheet(`a { color: red }
+@media print { a { color: black } }
+@media (prefers-color-scheme: dark) { a { color: white } }
+@keyframes fade { to { color: blue } }`)}
+	var tests = []struct {
+		sc  *ContextScore
+		exp []float64
+	}{
+		{&ContextScore{}, []float64{1, 0, 1, 0}},
+		{&ContextScore{Print: true, Keyframes: true}, []float64{1, 1, 1, 1}},
+		{&ContextScore{Scheme: "light"}, []float64{1, 0, 0, 0}},
+		{&ContextScore{Scheme: "dark"}, []float64{1, 0, 1, 0}},
+	}
+	for ti, tt := range tests {
+		for i, cm := range cml.Mentions {
+			if s := tt.sc.Score(cml, cm); s != tt.exp[i] {
+				t.Errorf("Test #%d expected score %g for ColorMention #%d, got %g", ti, tt.exp[i], i, s)
+			}
+		}
+	}
+	p := Group(cml, &ContextScore{})
+	if len(p) != 2 {
+		t.Errorf("Expecting ignored mentions to be dropped, got %d colors", len(p))
+	}
+}
+
+func TestSpecificityScore(t *testing.T) {
+	mentions, _ := css.ParseHTML(`<style>
+body, #main .nav a:hover, .a { color: red }
+@media print { :where(#x) p { color: black } }
+</style><div style="color: blue"></div>`)
+	cml := &css.CML{Mentions: mentions}
+	var tests = []struct {
+		sc  *SpecificityScore
+		exp []float64
+	}{
+		{&SpecificityScore{}, []float64{8, 1, 7, 8, 1}},
+		{&SpecificityScore{Max: 20}, []float64{20, 12, 19, 20, 1}},
+		{&SpecificityScore{Scorer: &ContextScore{}}, []float64{8, 1, 7, 0, 1}},
+	}
+	for ti, tt := range tests {
+		if len(cml.Mentions) != len(tt.exp) {
+			t.Fatalf("Expecting %d ColorMentions, got %d", len(tt.exp), len(cml.Mentions))
+		}
+		for i, cm := range cml.Mentions {
+			if s := tt.sc.Score(cml, cm); s != tt.exp[i] {
+				t.Errorf("Test #%d expected score %g for ColorMention #%d %s, got %g", ti, tt.exp[i], i, cm.Selector, s)
+			}
+		}
+	}
+}
+
+func TestContextScore_DropUnused(t *testing.T) {
+	cml := &css.CML{Mentions: []*css.ColorMention{{}, {Unused: true}}}
+	for i, exp := range []float64{1, 0} {
+		if s := (&ContextScore{DropUnused: true}).Score(cml, cml.Mentions[i]); s != exp {
+			t.Errorf("Expected score %g for ColorMention #%d, got %g", exp, i, s)
+		}
+	}
+	if s := (&ContextScore{}).Score(cml, cml.Mentions[1]); s != 1 {
+		t.Errorf("Expected unused mentions to be kept by default, got score %g", s)
+	}
+}
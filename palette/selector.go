@@ -0,0 +1,227 @@
+package palette
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/nochso/colourl/css"
+)
+
+// Explainer is a Scorer that can report why a ColorMention scored as it did.
+type Explainer interface {
+	Scorer
+	Explain(cml *css.CML, cm *css.ColorMention) Explanation
+}
+
+// Explanation of a score as a sum of points.
+type Explanation struct {
+	Score   float64
+	Reasons []Reason
+}
+
+// Reason adds points to a score.
+type Reason struct {
+	Description string
+	Points      float64
+}
+
+// String lists each reason and the resulting score, e.g. `base +4, identity element a +4 = 8`.
+func (e Explanation) String() string {
+	reasons := make([]string, len(e.Reasons))
+	for i, r := range e.Reasons {
+		reasons[i] = fmt.Sprintf("%s %+g", r.Description, r.Points)
+	}
+	return fmt.Sprintf("%s = %g", strings.Join(reasons, ", "), e.Score)
+}
+
+// SelectorWeights are the points added by SelectorScore for features of a selector.
+// Penalties are negative. Weights missing from JSON or YAML fall back on DefaultSelectorWeights.
+type SelectorWeights struct {
+	// Base points of every mention
+	Base float64 `json:"base" yaml:"base"`
+	// Identity is added if the subject of a selector is an element defining the identity
+	// of a site, e.g. `:root`, `body`, `header`, `nav`, links or buttons.
+	Identity float64 `json:"identity" yaml:"identity"`
+	// State is added once if a selector depends on a user interaction like `:hover` or `:focus`.
+	State float64 `json:"state" yaml:"state"`
+	// Depth is added for every compound selector beyond MaxDepth, e.g. 3 for `main .card ul li`.
+	Depth    float64 `json:"depth" yaml:"depth"`
+	MaxDepth int     `json:"maxDepth" yaml:"maxDepth"`
+	// Specificity is added for every point beyond MaxSpecificity.
+	// The specificity (a,b,c) is weighed as 4a+2b+c points like SpecificityScore.
+	Specificity    float64 `json:"specificity" yaml:"specificity"`
+	MaxSpecificity int     `json:"maxSpecificity" yaml:"maxSpecificity"`
+}
+
+// plainSelectorWeights has no custom unmarshalling.
+type plainSelectorWeights SelectorWeights
+
+// UnmarshalJSON decodes weights on top of DefaultSelectorWeights.
+func (w *SelectorWeights) UnmarshalJSON(b []byte) error {
+	p := plainSelectorWeights(DefaultSelectorWeights)
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+	*w = SelectorWeights(p)
+	return nil
+}
+
+// UnmarshalYAML decodes weights on top of DefaultSelectorWeights.
+func (w *SelectorWeights) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	p := plainSelectorWeights(DefaultSelectorWeights)
+	if err := unmarshal(&p); err != nil {
+		return err
+	}
+	*w = SelectorWeights(p)
+	return nil
+}
+
+// DefaultSelectorWeights are used by SelectorScore if no weights are set.
+var DefaultSelectorWeights = SelectorWeights{
+	Base:           4,
+	Identity:       4,
+	State:          -2,
+	Depth:          -1,
+	MaxDepth:       2,
+	Specificity:    -1,
+	MaxSpecificity: 4,
+}
+
+// identityTags are elements defining the identity of a site.
+var identityTags = map[string]bool{
+	"html":   true,
+	"body":   true,
+	"header": true,
+	"nav":    true,
+	"a":      true,
+	"button": true,
+}
+
+// statePseudos are pseudo-classes depending on a user interaction.
+var statePseudos = map[string]bool{
+	"hover":         true,
+	"focus":         true,
+	"focus-visible": true,
+	"focus-within":  true,
+	"active":        true,
+	"visited":       true,
+}
+
+// SelectorScore wraps another Scorer and weighs colors by the meaning of their selector.
+// Rules of elements defining the identity of a site count more, while deep and highly
+// specific selectors or interaction states count less.
+//
+// The score of the wrapped Scorer is multiplied by the sum of points, which is at least 1.
+// The points of all reasons of an Explanation add up to the score.
+// Inline styles, HTML attributes and other sources without a selector only get the Base points.
+type SelectorScore struct {
+	// Scorer for mentions before weighing their selector. Falls back on palette.SumScore if nil.
+	Scorer Scorer
+	// Weights fall back on DefaultSelectorWeights if nil.
+	Weights *SelectorWeights
+}
+
+// Score implements palette.Scorer
+func (sc *SelectorScore) Score(cml *css.CML, cm *css.ColorMention) float64 {
+	return sc.Explain(cml, cm).Score
+}
+
+// Explain implements palette.Explainer
+func (sc *SelectorScore) Explain(cml *css.CML, cm *css.ColorMention) Explanation {
+	w := sc.Weights
+	if w == nil {
+		w = &DefaultSelectorWeights
+	}
+	e := Explanation{Reasons: []Reason{{"base", w.Base}}}
+	add := func(points float64, format string, a ...interface{}) {
+		if points != 0 {
+			e.Reasons = append(e.Reasons, Reason{fmt.Sprintf(format, a...), points})
+		}
+	}
+	var sel css.Selector
+	if !cm.Inline && !cm.IsHTML() && cm.Source == css.SourceDocument {
+		if list, err := css.ParseSelectors(cm.Selector); err == nil && len(list) == 1 {
+			sel = list[0]
+		}
+	}
+	if len(sel) > 0 {
+		if subject := identitySubject(sel[len(sel)-1]); subject != "" {
+			add(w.Identity, "identity element %s", subject)
+		}
+		if state := stateOf(sel); state != "" {
+			add(w.State, "state :%s", state)
+		}
+		if len(sel) > w.MaxDepth {
+			add(w.Depth*float64(len(sel)-w.MaxDepth), "depth %d", len(sel))
+		}
+		points := 4*cm.Specificity[0] + 2*cm.Specificity[1] + cm.Specificity[2]
+		if points > w.MaxSpecificity {
+			add(w.Specificity*float64(points-w.MaxSpecificity), "specificity %s", cm.Specificity)
+		}
+	}
+	points := 0.0
+	for _, r := range e.Reasons {
+		points += r.Points
+	}
+	if points < 1 {
+		e.Reasons = append(e.Reasons, Reason{"minimum", 1 - points})
+		points = 1
+	}
+	var s float64
+	if sc.Scorer == nil {
+		s = (&SumScore{}).Score(cml, cm)
+	} else {
+		s = sc.Scorer.Score(cml, cm)
+	}
+	e.Score = s * points
+	if s != 1 {
+		e.Reasons = append(e.Reasons, Reason{fmt.Sprintf("times score %g", s), e.Score - points})
+	}
+	return e
+}
+
+var _ Explainer = (*SelectorScore)(nil)
+
+// identitySubject returns the name of an identity element targeted by a compound selector.
+func identitySubject(c *css.Compound) string {
+	tag := strings.ToLower(c.Tag)
+	if identityTags[tag] {
+		return tag
+	}
+	for _, p := range c.Pseudos {
+		if p.Name == "root" {
+			return ":root"
+		}
+	}
+	if tag == "input" {
+		for _, a := range c.Attrs {
+			if a.Name == "type" && (a.Value == "button" || a.Value == "submit") {
+				return "input[type=" + a.Value + "]"
+			}
+		}
+	}
+	for _, class := range c.Classes {
+		if class == "btn" || class == "button" || strings.HasPrefix(class, "btn-") || strings.HasPrefix(class, "button-") {
+			return "." + class
+		}
+	}
+	return ""
+}
+
+// stateOf returns the first interaction state pseudo-class of a selector.
+func stateOf(sel css.Selector) string {
+	for _, c := range sel {
+		for _, p := range c.Pseudos {
+			if statePseudos[p.Name] {
+				return p.Name
+			}
+			for _, s := range p.Selectors {
+				if state := stateOf(s); state != "" {
+					return state
+				}
+			}
+		}
+	}
+	return ""
+}
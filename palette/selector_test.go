@@ -0,0 +1,48 @@
+package palette
+
+import (
+	"testing"
+
+	"github.com/nochso/colourl/css"
+)
+
+func TestSelectorScore(t *testing.T) {
+	cml := &css.CML{Mentions: css.ParseStylesheet(`
+:root { color: #000 }
+nav a { color: #111 }
+.card { color: #222 }
+a:hover { color: #333 }
+main .card ul li.item { color: #444 }
+#a #b:focus { color: #555 }
+.btn-primary { color: #666 }`)}
+	var tests = []struct {
+		score   float64
+		explain string
+	}{
+		{8, "base +4, identity element :root +4 = 8"},
+		{8, "base +4, identity element a +4 = 8"},
+		{4, "base +4 = 4"},
+		{6, "base +4, identity element a +4, state :hover -2 = 6"},
+		{1, "base +4, depth 4 -2, specificity (0,2,3) -3, minimum +2 = 1"},
+		{1, "base +4, state :focus -2, specificity (2,1,0) -6, minimum +5 = 1"},
+		{8, "base +4, identity element .btn-primary +4 = 8"},
+	}
+	if len(cml.Mentions) != len(tests) {
+		t.Fatalf("Expecting %d ColorMentions, got %d", len(tests), len(cml.Mentions))
+	}
+	sc := &SelectorScore{}
+	for i, cm := range cml.Mentions {
+		e := sc.Explain(cml, cm)
+		if e.Score != tests[i].score || sc.Score(cml, cm) != tests[i].score {
+			t.Errorf("Expecting score %g for %s, got %g", tests[i].score, cm.Selector, e.Score)
+		}
+		if e.String() != tests[i].explain {
+			t.Errorf("Expecting explanation %q for %s, got %q", tests[i].explain, cm.Selector, e)
+		}
+	}
+	weighted := &SelectorScore{Scorer: &RoleScore{}, Weights: &SelectorWeights{Base: 1, Identity: 9}}
+	e := weighted.Explain(cml, cml.Mentions[0])
+	if e.Score != 30 || e.String() != "base +1, identity element :root +9, times score 3 +20 = 30" {
+		t.Errorf("Expecting configured weights times the wrapped score, got %s", e)
+	}
+}
@@ -0,0 +1,132 @@
+package palette
+
+import (
+	"sort"
+
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// Metric measures the perceptual distance between two colors.
+type Metric func(a, b colorful.Color) float64
+
+// Metrics is a map of color distance metrics with names as keys.
+var Metrics = map[string]Metric{
+	"cie76":     colorful.Color.DistanceCIE76,
+	"cie94":     colorful.Color.DistanceCIE94,
+	"ciede2000": colorful.Color.DistanceCIEDE2000,
+}
+
+// TrimOptions control which colors are considered boring or redundant by Palette.TrimWith.
+// Options with a zero value do not filter anything.
+type TrimOptions struct {
+	// MinSaturation is the HSV saturation below which colors are boring.
+	MinSaturation float64
+	// MinLightness and MaxLightness limit the CIE L*a*b* lightness of colors from 0 to 1.
+	// Colors outside of the band are boring. A MaxLightness of 0 is ignored.
+	MinLightness, MaxLightness float64
+	// Extremes is the distance to white or black within which colors are boring.
+	Extremes float64
+	// MinDistance between kept colors. Colors too close to a color with a higher score are dropped.
+	MinDistance float64
+	// Metric for Extremes and MinDistance. Falls back on CIE76 if nil.
+	Metric Metric
+	// Include colors even if they are boring, e.g. a known brand color.
+	// A color is included if it or a member of its cluster matches by hex value.
+	Include []colorful.Color
+	// IncludeTheme includes colors declared as theme color by the website, see ColorScore.Theme.
+	IncludeTheme bool
+}
+
+// DefaultTrimOptions are used by Palette.Trim.
+// Gray and almost white or black colors are boring.
+var DefaultTrimOptions = TrimOptions{
+	MinSaturation: 0.1,
+	Extremes:      0.05,
+}
+
+// Boring reports whether a color is filtered by the saturation, lightness or extremes.
+func (opts TrimOptions) Boring(c *colorful.Color) bool {
+	_, s, _ := c.Hsv()
+	if s < opts.MinSaturation {
+		return true
+	}
+	l, _, _ := c.Lab()
+	if l < opts.MinLightness || opts.MaxLightness > 0 && l > opts.MaxLightness {
+		return true
+	}
+	if opts.Extremes > 0 {
+		white, _ := colorful.Hex("#ffffff")
+		black, _ := colorful.Hex("#000000")
+		return opts.distance(*c, white) <= opts.Extremes || opts.distance(*c, black) <= opts.Extremes
+	}
+	return false
+}
+
+// distance between two colors using the Metric.
+func (opts TrimOptions) distance(a, b colorful.Color) float64 {
+	if opts.Metric == nil {
+		return a.DistanceCIE76(b)
+	}
+	return opts.Metric(a, b)
+}
+
+// included reports whether a color or any member of its cluster is in Include
+// or a theme color if IncludeTheme is set.
+func (opts TrimOptions) included(c *ColorScore) bool {
+	if opts.IncludeTheme && c.Theme {
+		return true
+	}
+	for _, inc := range opts.Include {
+		if c.Color.Hex() == inc.Hex() {
+			return true
+		}
+		for _, m := range c.Members {
+			if m.Color.Hex() == inc.Hex() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TrimWith returns a new Palette with max amount of colors sorted by score.
+// Included colors are always kept. Boring colors only fill the slots left by all others,
+// while colors closer than MinDistance to a kept color are always dropped.
+func (p Palette) TrimWith(max int, opts TrimOptions) Palette {
+	max = minInt(max, len(p))
+	// Map kept colors to their position in p
+	kept := map[*ColorScore]int{}
+	keep := func(i int, c *ColorScore) bool {
+		if len(kept) == max {
+			return false
+		}
+		for k := range kept {
+			if opts.MinDistance > 0 && opts.distance(*c.Color, *k.Color) < opts.MinDistance {
+				return true
+			}
+		}
+		kept[c] = i
+		return true
+	}
+	for i, c := range p {
+		if opts.included(c) && !keep(i, c) {
+			break
+		}
+	}
+	for _, boring := range []bool{false, true} {
+		for i, c := range p {
+			if _, ok := kept[c]; ok || opts.Boring(c.Color) != boring {
+				continue
+			}
+			if !keep(i, c) {
+				break
+			}
+		}
+	}
+	scores := make(Palette, 0, len(kept))
+	for c := range kept {
+		scores = append(scores, c)
+	}
+	sort.Slice(scores, func(i, j int) bool { return kept[scores[i]] < kept[scores[j]] })
+	return scores
+}
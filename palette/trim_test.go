@@ -0,0 +1,76 @@
+package palette
+
+import (
+	"testing"
+
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/nochso/colourl/css"
+)
+
+func TestPalette_TrimWith(t *testing.T) {
+	newPalette := func(hexes ...string) Palette {
+		p := Palette{}
+		for i, hex := range hexes {
+			c, _ := colorful.Hex(hex)
+			p = append(p, &ColorScore{Score: float64(len(hexes) - i), Color: &c})
+		}
+		return p
+	}
+	theme, _ := colorful.Hex("#fefefe")
+	themed := newPalette("#888888", "#00ff00")
+	themed[1].Theme = true
+	var tests = []struct {
+		opts TrimOptions
+		max  int
+		exp  []string
+		// in defaults to the palette #fefefe, #ff0000, #fe0101, #0000ff, #888888
+		in Palette
+	}{
+		{DefaultTrimOptions, 3, []string{"#ff0000", "#fe0101", "#0000ff"}, nil},
+		{TrimOptions{}, 3, []string{"#fefefe", "#ff0000", "#fe0101"}, nil},
+		{TrimOptions{MinDistance: 0.05}, 3, []string{"#fefefe", "#ff0000", "#0000ff"}, nil},
+		{TrimOptions{MinDistance: 0.05, Metric: Metrics["ciede2000"]}, 3, []string{"#fefefe", "#ff0000", "#0000ff"}, nil},
+		{TrimOptions{MaxLightness: 0.9, MinDistance: 0.05}, 3, []string{"#ff0000", "#0000ff", "#888888"}, nil},
+		{TrimOptions{MinSaturation: 0.1, MinLightness: 0.4}, 2, []string{"#ff0000", "#fe0101"}, nil},
+		{TrimOptions{MinSaturation: 0.1, Extremes: 0.05, MinDistance: 0.05, Include: []colorful.Color{theme}}, 2, []string{"#fefefe", "#ff0000"}, nil},
+		// Boring colors by score fill the slots left by all others
+		{DefaultTrimOptions, 4, []string{"#fefefe", "#ff0000", "#fe0101", "#0000ff"}, nil},
+		{TrimOptions{MinSaturation: 0.1, IncludeTheme: true}, 2, []string{"#888888", "#00ff00"}, themed},
+		{TrimOptions{MinSaturation: 0.1, MinDistance: 0.05}, 2, []string{"#888888", "#ff0000"}, newPalette("#888888", "#ff0000", "#fe0101")},
+	}
+	for i, tt := range tests {
+		if tt.in == nil {
+			tt.in = newPalette("#fefefe", "#ff0000", "#fe0101", "#0000ff", "#888888")
+		}
+		p := tt.in.TrimWith(tt.max, tt.opts)
+		var hexes []string
+		for _, c := range p {
+			hexes = append(hexes, c.Color.Hex())
+		}
+		if len(hexes) != len(tt.exp) {
+			t.Errorf("Test #%d expected %v, got %v", i, tt.exp, hexes)
+			continue
+		}
+		for j := range hexes {
+			if hexes[j] != tt.exp[j] {
+				t.Errorf("Test #%d expected %v, got %v", i, tt.exp, hexes)
+				break
+			}
+		}
+	}
+}
+
+func TestPalette_TrimWith_IncludeTheme(t *testing.T) {
+	cml := &css.CML{Mentions: css.ParseStylesheet(`a { color: red } b { color: blue } i { color: #fdfdfd }`)}
+	theme, _ := colorful.Hex("#fefefe")
+	cml.Mentions = append(cml.Mentions, css.New(&theme, css.PropThemeColor, "meta"))
+	for _, p := range []Palette{Group(cml, nil), GroupWith(cml, nil, GroupOptions{Tolerance: 0.02})} {
+		trimmed := p.TrimWith(2, TrimOptions{MinSaturation: 0.1, IncludeTheme: true})
+		if len(trimmed) != 2 || !trimmed[0].Theme && !trimmed[1].Theme {
+			t.Errorf("Expecting the theme color to be kept, got %v", trimmed)
+		}
+		if trimmed = p.TrimWith(2, DefaultTrimOptions); trimmed[0].Theme || trimmed[1].Theme {
+			t.Errorf("Expecting the theme color to be trimmed without IncludeTheme, got %v", trimmed)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package raster
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sort"
+
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// Cluster limits of the k-means algorithm used by Cluster.
+const (
+	maxClusterSamples    = 64 * 64
+	maxClusterIterations = 16
+)
+
+// sample is a pixel converted to CIE Lab.
+type sample struct {
+	l, a, b float64
+	weight  float64
+}
+
+// Cluster returns the dominant colors of an image sorted by weight.
+// Pixels are grouped into at most k clusters using k-means in CIE Lab space,
+// starting with the most common colors found by Quantize.
+// Transparent pixels are ignored and translucent pixels are weighted by their opacity.
+func Cluster(img image.Image, k int) []Color {
+	samples := labSamples(img)
+	if len(samples) == 0 || k <= 0 {
+		return nil
+	}
+	var centroids []sample
+	for _, c := range Quantize(img, k) {
+		l, a, b := c.Color.Lab()
+		centroids = append(centroids, sample{l: l, a: a, b: b})
+	}
+	assigned := make([]int, len(samples))
+	for it := 0; it < maxClusterIterations; it++ {
+		changed := false
+		for i, s := range samples {
+			nearest := nearestCentroid(s, centroids)
+			if nearest != assigned[i] {
+				changed = true
+				assigned[i] = nearest
+			}
+		}
+		sums := make([]sample, len(centroids))
+		for i, s := range samples {
+			c := &sums[assigned[i]]
+			c.l += s.l * s.weight
+			c.a += s.a * s.weight
+			c.b += s.b * s.weight
+			c.weight += s.weight
+		}
+		for i, c := range sums {
+			if c.weight > 0 {
+				centroids[i] = sample{l: c.l / c.weight, a: c.a / c.weight, b: c.b / c.weight, weight: c.weight}
+			} else {
+				centroids[i].weight = 0
+			}
+		}
+		// Centroids are stable once no sample changes its cluster
+		if !changed && it > 0 {
+			break
+		}
+	}
+	var total float64
+	for _, c := range centroids {
+		total += c.weight
+	}
+	var colors []Color
+	for _, c := range centroids {
+		if c.weight == 0 {
+			continue
+		}
+		colors = append(colors, Color{
+			Color:  colorful.Lab(c.l, c.a, c.b).Clamped(),
+			Weight: c.weight / total,
+		})
+	}
+	sort.SliceStable(colors, func(i, j int) bool {
+		return colors[i].Weight > colors[j].Weight
+	})
+	return colors
+}
+
+// nearestCentroid returns the index of the centroid closest to a sample.
+func nearestCentroid(s sample, centroids []sample) int {
+	nearest, min := 0, math.Inf(1)
+	for i, c := range centroids {
+		d := (s.l-c.l)*(s.l-c.l) + (s.a-c.a)*(s.a-c.a) + (s.b-c.b)*(s.b-c.b)
+		if d < min {
+			nearest, min = i, d
+		}
+	}
+	return nearest
+}
+
+// labSamples converts evenly sampled pixels of an image to CIE Lab.
+func labSamples(img image.Image) []sample {
+	bounds := img.Bounds()
+	step := 1
+	if n := bounds.Dx() * bounds.Dy(); n > maxClusterSamples {
+		step = int(math.Ceil(math.Sqrt(float64(n) / maxClusterSamples)))
+	}
+	var samples []sample
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A == 0 {
+				continue
+			}
+			l, a, b := colorful.Color{R: float64(c.R) / 0xff, G: float64(c.G) / 0xff, B: float64(c.B) / 0xff}.Lab()
+			samples = append(samples, sample{l: l, a: a, b: b, weight: float64(c.A) / 0xff})
+		}
+	}
+	return samples
+}
@@ -0,0 +1,172 @@
+package raster
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// pngSignature starts every PNG file, including PNG images embedded in ICO files.
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// icoEntry is a single image of an ICO file.
+type icoEntry struct {
+	width, height int
+	bpp           int
+	data          []byte
+}
+
+// isICO reports whether b starts with the header of an ICO or CUR file.
+func isICO(b []byte) bool {
+	return len(b) >= 6 && b[0] == 0 && b[1] == 0 && (b[2] == 1 || b[2] == 2) && b[3] == 0 && (b[4] != 0 || b[5] != 0)
+}
+
+// decodeICO decodes the largest image of an ICO file.
+// Images are either embedded PNG files or device independent bitmaps.
+func decodeICO(b []byte) (image.Image, error) {
+	count := int(binary.LittleEndian.Uint16(b[4:]))
+	var best *icoEntry
+	for i := 0; i < count; i++ {
+		h := b[6+i*16:]
+		if len(h) < 16 {
+			return nil, errors.New("ICO directory is truncated")
+		}
+		e := &icoEntry{
+			width:  int(h[0]),
+			height: int(h[1]),
+			bpp:    int(binary.LittleEndian.Uint16(h[6:])),
+		}
+		// A size of 0 means 256 pixels
+		if e.width == 0 {
+			e.width = 256
+		}
+		if e.height == 0 {
+			e.height = 256
+		}
+		size := int(binary.LittleEndian.Uint32(h[8:]))
+		offset := int(binary.LittleEndian.Uint32(h[12:]))
+		if offset < 0 || size < 0 || offset+size > len(b) || offset+size < offset {
+			continue
+		}
+		e.data = b[offset : offset+size]
+		if best == nil || e.width*e.height > best.width*best.height ||
+			e.width*e.height == best.width*best.height && e.bpp > best.bpp {
+			best = e
+		}
+	}
+	if best == nil {
+		return nil, errors.New("ICO file contains no valid image")
+	}
+	if bytes.HasPrefix(best.data, pngSignature) {
+		cfg, err := png.DecodeConfig(bytes.NewReader(best.data))
+		if err != nil {
+			return nil, err
+		}
+		err = checkPixels(cfg.Width, cfg.Height)
+		if err != nil {
+			return nil, err
+		}
+		return png.Decode(bytes.NewReader(best.data))
+	}
+	return decodeDIB(best.data)
+}
+
+// decodeDIB decodes an uncompressed device independent bitmap as used by ICO files.
+// The height of the bitmap includes the 1 bit AND mask following the pixels.
+func decodeDIB(b []byte) (image.Image, error) {
+	if len(b) < 40 {
+		return nil, errors.New("DIB header is truncated")
+	}
+	headerSize := int(binary.LittleEndian.Uint32(b))
+	w := int(int32(binary.LittleEndian.Uint32(b[4:])))
+	h := int(int32(binary.LittleEndian.Uint32(b[8:]))) / 2
+	bpp := int(binary.LittleEndian.Uint16(b[14:]))
+	compression := binary.LittleEndian.Uint32(b[16:])
+	colorsUsed := int(binary.LittleEndian.Uint32(b[32:]))
+	err := checkPixels(w, h)
+	if err != nil {
+		return nil, err
+	}
+	// BI_RGB or BI_BITFIELDS with the default masks of 32 bit bitmaps
+	if compression != 0 && !(compression == 3 && bpp == 32) {
+		return nil, errors.New("compressed DIB is not supported")
+	}
+	if headerSize < 40 || headerSize > len(b) {
+		return nil, errors.New("DIB header size is invalid")
+	}
+	var palette color.Palette
+	offset := headerSize
+	if compression == 3 && headerSize == 40 {
+		offset += 12 // Masks following the header
+	}
+	switch bpp {
+	case 1, 4, 8:
+		if colorsUsed <= 0 || colorsUsed > 1<<uint(bpp) {
+			colorsUsed = 1 << uint(bpp)
+		}
+		if offset+colorsUsed*4 > len(b) {
+			return nil, errors.New("DIB palette is truncated")
+		}
+		for i := 0; i < colorsUsed; i++ {
+			c := b[offset+i*4:]
+			palette = append(palette, color.NRGBA{R: c[2], G: c[1], B: c[0], A: 0xff})
+		}
+		offset += colorsUsed * 4
+	case 24, 32:
+	default:
+		return nil, errors.New("DIB bit depth is not supported")
+	}
+	stride := (w*bpp + 31) / 32 * 4
+	maskStride := (w + 31) / 32 * 4
+	if offset+stride*h > len(b) {
+		return nil, errors.New("DIB pixels are truncated")
+	}
+	pixels := b[offset : offset+stride*h]
+	// The AND mask is missing in some files
+	var mask []byte
+	if end := offset + stride*h + maskStride*h; end <= len(b) {
+		mask = b[offset+stride*h : end]
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	hasAlpha := false
+	for y := 0; y < h; y++ {
+		row := pixels[(h-1-y)*stride:] // Rows are stored bottom-up
+		for x := 0; x < w; x++ {
+			var c color.NRGBA
+			switch bpp {
+			case 32:
+				c = color.NRGBA{R: row[x*4+2], G: row[x*4+1], B: row[x*4], A: row[x*4+3]}
+				hasAlpha = hasAlpha || c.A != 0
+			case 24:
+				c = color.NRGBA{R: row[x*3+2], G: row[x*3+1], B: row[x*3], A: 0xff}
+			default:
+				bit := x * bpp
+				i := int(row[bit/8]>>uint(8-bpp-bit%8)) & (1<<uint(bpp) - 1)
+				if i < len(palette) {
+					c = palette[i].(color.NRGBA)
+				}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := img.NRGBAAt(x, y)
+			switch {
+			case bpp == 32 && !hasAlpha:
+				// Bitmaps without alpha channel rely on the AND mask only
+				c.A = 0xff
+			case bpp == 32:
+				continue
+			}
+			if mask != nil && mask[(h-1-y)*maskStride+x/8]>>uint(7-x%8)&1 == 1 {
+				c.A = 0
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img, nil
+}
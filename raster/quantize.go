@@ -0,0 +1,86 @@
+package raster
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sort"
+
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// maxSamples limits the amount of pixels that are sampled by Quantize.
+const maxSamples = 256 * 256
+
+// Color is a quantized color of an image.
+type Color struct {
+	Color colorful.Color
+	// Weight is the share of opaque pixels with this color from 0 to 1.
+	Weight float64
+}
+
+// bucket accumulates pixels with similar colors.
+type bucket struct {
+	key     int
+	r, g, b float64
+	weight  float64
+}
+
+// Quantize reduces the pixels of an image to at most max colors sorted by weight.
+// Similar pixels are grouped using 5 bits per channel. Transparent pixels are ignored
+// and translucent pixels are weighted by their opacity.
+// Large images are sampled evenly. All colors are returned if max is 0 or less.
+func Quantize(img image.Image, max int) []Color {
+	bounds := img.Bounds()
+	step := 1
+	if n := bounds.Dx() * bounds.Dy(); n > maxSamples {
+		step = int(math.Ceil(math.Sqrt(float64(n) / maxSamples)))
+	}
+	buckets := map[int]*bucket{}
+	var total float64
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A == 0 {
+				continue
+			}
+			w := float64(c.A) / 0xff
+			key := int(c.R>>3)<<10 | int(c.G>>3)<<5 | int(c.B>>3)
+			b, ok := buckets[key]
+			if !ok {
+				b = &bucket{key: key}
+				buckets[key] = b
+			}
+			b.r += float64(c.R) * w
+			b.g += float64(c.G) * w
+			b.b += float64(c.B) * w
+			b.weight += w
+			total += w
+		}
+	}
+	list := make([]*bucket, 0, len(buckets))
+	for _, b := range buckets {
+		list = append(list, b)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].weight != list[j].weight {
+			return list[i].weight > list[j].weight
+		}
+		return list[i].key < list[j].key
+	})
+	if max > 0 && len(list) > max {
+		list = list[:max]
+	}
+	colors := make([]Color, len(list))
+	for i, b := range list {
+		colors[i] = Color{
+			Color: colorful.Color{
+				R: b.r / b.weight / 0xff,
+				G: b.g / b.weight / 0xff,
+				B: b.b / b.weight / 0xff,
+			},
+			Weight: b.weight / total,
+		}
+	}
+	return colors
+}
@@ -0,0 +1,43 @@
+// Package raster decodes raster images like favicons and quantizes their pixels into weighted colors.
+package raster
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	// Register decoders of common image formats
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// MaxPixels limits the dimensions of images that are decoded.
+var MaxPixels = 4096 * 4096
+
+// Decode a PNG, GIF, JPEG or ICO image.
+// The largest image of an ICO file is used.
+func Decode(b []byte) (image.Image, error) {
+	if isICO(b) {
+		return decodeICO(b)
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	err = checkPixels(cfg.Width, cfg.Height)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(b))
+	return img, err
+}
+
+func checkPixels(w, h int) error {
+	if w <= 0 || h <= 0 {
+		return fmt.Errorf("Image with size %dx%d is empty", w, h)
+	}
+	if w*h > MaxPixels || w > MaxPixels || h > MaxPixels {
+		return fmt.Errorf("Image with size %dx%d exceeds MaxPixels %d", w, h, MaxPixels)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package raster
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+// testImage returns a 4x4 image with a red top half, a blue bottom left and a transparent bottom right.
+func testImage() *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			switch {
+			case y < 2:
+				img.SetNRGBA(x, y, color.NRGBA{R: 0xff, A: 0xff})
+			case x < 2:
+				img.SetNRGBA(x, y, color.NRGBA{B: 0xff, A: 0xff})
+			}
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	err := png.Encode(&buf, img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// encodeICO returns an ICO file containing the given images.
+func encodeICO(images ...[]byte) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0, 0, 1, 0, byte(len(images)), 0})
+	offset := 6 + 16*len(images)
+	for _, data := range images {
+		buf.Write([]byte{4, 4, 0, 0, 1, 0, 32, 0})
+		binary.Write(&buf, binary.LittleEndian, uint32(len(data)))
+		binary.Write(&buf, binary.LittleEndian, uint32(offset))
+		offset += len(data)
+	}
+	for _, data := range images {
+		buf.Write(data)
+	}
+	return buf.Bytes()
+}
+
+// encodeDIB returns a 32 bit bitmap of img as used by ICO files.
+func encodeDIB(img *image.NRGBA) []byte {
+	var buf bytes.Buffer
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	for _, v := range []interface{}{uint32(40), int32(w), int32(h * 2), uint16(1), uint16(32), uint32(0), uint32(0), int32(0), int32(0), uint32(0), uint32(0)} {
+		binary.Write(&buf, binary.LittleEndian, v)
+	}
+	for y := h - 1; y >= 0; y-- {
+		for x := 0; x < w; x++ {
+			c := img.NRGBAAt(x, y)
+			buf.Write([]byte{c.B, c.G, c.R, c.A})
+		}
+	}
+	buf.Write(make([]byte, (w+31)/32*4*h)) // AND mask
+	return buf.Bytes()
+}
+
+func TestDecode(t *testing.T) {
+	png := encodePNG(t, testImage())
+	var tests = []struct {
+		name string
+		in   []byte
+	}{
+		{"png", png},
+		{"ico with png", encodeICO(png)},
+		{"ico with dib", encodeICO(encodeDIB(testImage()))},
+	}
+	for _, test := range tests {
+		img, err := Decode(test.in)
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		colors := Quantize(img, 0)
+		if len(colors) != 2 {
+			t.Errorf("%s: expecting 2 colors, got %d", test.name, len(colors))
+			continue
+		}
+		if colors[0].Color.Hex() != "#ff0000" || colors[0].Weight != 2.0/3 {
+			t.Errorf("%s: expecting red with weight 0.67, got %s with %f", test.name, colors[0].Color.Hex(), colors[0].Weight)
+		}
+		if colors[1].Color.Hex() != "#0000ff" || colors[1].Weight != 1.0/3 {
+			t.Errorf("%s: expecting blue with weight 0.33, got %s with %f", test.name, colors[1].Color.Hex(), colors[1].Weight)
+		}
+	}
+}
+
+func TestDecodeICOMask(t *testing.T) {
+	// 8x8 bitmap with 1 bit per pixel: left half uses color 1, right half is masked
+	var buf bytes.Buffer
+	for _, v := range []interface{}{uint32(40), int32(8), int32(16), uint16(1), uint16(1), uint32(0), uint32(0), int32(0), int32(0), uint32(2), uint32(0)} {
+		binary.Write(&buf, binary.LittleEndian, v)
+	}
+	buf.Write([]byte{0, 0, 0, 0, 0, 0x80, 0, 0}) // black and green
+	for y := 0; y < 8; y++ {
+		buf.Write([]byte{0xf0, 0, 0, 0})
+	}
+	for y := 0; y < 8; y++ {
+		buf.Write([]byte{0x0f, 0, 0, 0})
+	}
+	img, err := Decode(encodeICO(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	colors := Quantize(img, 0)
+	if len(colors) != 1 || colors[0].Color.Hex() != "#008000" {
+		t.Fatalf("Expecting only green, got %v", colors)
+	}
+}
+
+func TestDecodeMaxPixels(t *testing.T) {
+	defer func(max int) { MaxPixels = max }(MaxPixels)
+	MaxPixels = 15
+	_, err := Decode(encodePNG(t, testImage()))
+	if err == nil {
+		t.Error("Expecting error when exceeding MaxPixels")
+	}
+}
+
+func TestQuantizeMax(t *testing.T) {
+	colors := Quantize(testImage(), 1)
+	if len(colors) != 1 || colors[0].Color.Hex() != "#ff0000" {
+		t.Errorf("Expecting only red, got %v", colors)
+	}
+}
+
+func TestCluster(t *testing.T) {
+	// Gradient from dark to light red on the left and solid blue on the right
+	img := image.NewNRGBA(image.Rect(0, 0, 20, 10))
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 20; x++ {
+			if x < 10 {
+				img.SetNRGBA(x, y, color.NRGBA{R: uint8(200 + y*5), A: 0xff})
+			} else if x < 15 {
+				img.SetNRGBA(x, y, color.NRGBA{B: 0xff, A: 0xff})
+			}
+		}
+	}
+	colors := Cluster(img, 2)
+	if len(colors) != 2 {
+		t.Fatalf("Expecting 2 colors, got %d", len(colors))
+	}
+	if h, _, _ := colors[0].Color.Hsv(); h > 1 || colors[0].Weight != 2.0/3 {
+		t.Errorf("Expecting red with weight 0.67, got %s with %f", colors[0].Color.Hex(), colors[0].Weight)
+	}
+	if colors[1].Color.Hex() != "#0000ff" || colors[1].Weight != 1.0/3 {
+		t.Errorf("Expecting blue with weight 0.33, got %s with %f", colors[1].Color.Hex(), colors[1].Weight)
+	}
+}